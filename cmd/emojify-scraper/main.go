@@ -1,20 +1,163 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"go/format"
 	"log"
 	"os"
 	"path/filepath"
 
+	"github.com/urfave/cli/v3"
+
 	"github.com/damienbutt/emojify-go/internal/emoji"
 )
 
 func main() {
+	cmd := &cli.Command{
+		Name:  "emojify-scraper",
+		Usage: "generate and review the emoji alias database",
+		Description: `Without a subcommand, emojify-scraper fetches GitHub's gemoji database and
+generates internal/emoji/data_generated.go.
+
+Examples:
+  emojify-scraper
+  emojify-scraper diff
+  emojify-scraper diff --file emoji.json --json
+  emojify-scraper unicode --file emoji-test.txt
+  emojify-scraper versions --file emoji.json
+  emojify-scraper cldr --dir cldr/common --locale de --locale ja`,
+
+		Action: generateAction,
+
+		Commands: []*cli.Command{
+			{
+				Name:  "diff",
+				Usage: "compare the compiled EmojiMap against fresh gemoji data",
+				Description: `diff lists aliases that would be added, removed or changed if the emoji
+database were regenerated. Changes that only add or remove a variation
+selector (U+FE0E/U+FE0F) are flagged separately.
+
+The command exits with status 1 when aliases would be removed, unless
+--allow-removals is given.`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "read gemoji `JSON` from a local file instead of fetching it",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "print the report as JSON",
+					},
+					&cli.BoolFlag{
+						Name:  "allow-removals",
+						Usage: "exit successfully even when aliases would be removed",
+					},
+				},
+				Action: diffAction,
+			},
+			{
+				Name:  "unicode",
+				Usage: "generate CLDR-derived aliases from Unicode's emoji-test.txt",
+				Description: `unicode reads a local copy of emoji-test.txt
+(https://unicode.org/Public/emoji/latest/emoji-test.txt) and generates
+internal/emoji/unicode_generated.go.
+
+Every fully-qualified emoji is given an alias derived from its CLDR short
+name, so that all RGI emoji can be encoded and decoded even when gemoji has
+no alias for them. Qualification status, group, subgroup and emoji version
+are recorded for every sequence.`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Aliases:  []string{"f"},
+						Usage:    "path to emoji-test.txt",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "file to write the generated Go code to",
+						Value:   filepath.Join("internal", "emoji", "unicode_generated.go"),
+					},
+				},
+				Action: unicodeAction,
+			},
+			{
+				Name:  "cldr",
+				Usage: "generate localized emoji names and aliases from CLDR annotations",
+				Description: `cldr reads the emoji annotations of each locale from a local copy of CLDR's
+common directory (https://github.com/unicode-org/cldr), namely
+annotations/<locale>.xml and annotationsDerived/<locale>.xml, and generates
+internal/emoji/locale_generated.go. The CLDR release declared in
+dtd/ldml.dtd is recorded in its header.
+
+Every emoji is given a localized alias derived from its name in that
+language, such as :rakete: in German or :ロケット: in Japanese. Run the
+unicode command first, as only emoji listed in emoji-test.txt are kept.`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "dir",
+						Aliases:  []string{"d"},
+						Usage:    "path to CLDR's common `DIRECTORY`",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:    "locale",
+						Aliases: []string{"l"},
+						Usage:   "`LOCALE` to generate, such as de or pt_PT (repeatable)",
+						Value:   []string{"de", "ja"},
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "file to write the generated Go code to",
+						Value:   filepath.Join("internal", "emoji", "locale_generated.go"),
+					},
+				},
+				Action: cldrAction,
+			},
+			{
+				Name:  "versions",
+				Usage: "generate the Unicode version of each emoji from gemoji",
+				Description: `versions reads the unicode_version gemoji lists for each emoji and generates
+internal/emoji/versions_generated.go.
+
+--max-unicode falls back to these versions for emoji that emoji-test.txt
+does not list, and relies on them entirely in lite builds, which leave the
+emoji-test.txt data out.`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "read gemoji `JSON` from a local file instead of fetching it",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "file to write the generated Go code to",
+						Value:   filepath.Join("internal", "emoji", "versions_generated.go"),
+					},
+				},
+				Action: versionsAction,
+			},
+		},
+	}
+
+	if err := cmd.Run(context.Background(), os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateAction fetches gemoji data and writes the generated Go source
+func generateAction(ctx context.Context, c *cli.Command) error {
 	fmt.Println("Fetching emoji data from GitHub gemoji repository...")
 
 	result, err := emoji.ScrapeGitHubEmojis()
 	if err != nil {
-		log.Fatalf("Failed to scrape emoji data: %v", err)
+		return fmt.Errorf("failed to scrape emoji data: %w", err)
 	}
 
 	fmt.Printf("Successfully fetched %d emojis\n", result.EmojiCount)
@@ -25,9 +168,146 @@ func main() {
 	// Write to data.go file
 	dataFile := filepath.Join("internal", "emoji", "data_generated.go")
 	if err := os.WriteFile(dataFile, []byte(goCode), 0o644); err != nil {
-		log.Fatalf("Failed to write data file: %v", err)
+		return fmt.Errorf("failed to write data file: %w", err)
 	}
 
 	fmt.Printf("Generated %s with %d emoji mappings\n", dataFile, result.EmojiCount)
 	fmt.Println("Done! Remember to update the import in your code to use the generated data.")
+
+	return nil
+}
+
+// diffAction reports how fresh gemoji data differs from the compiled EmojiMap
+func diffAction(ctx context.Context, c *cli.Command) error {
+	var result *emoji.ScraperResult
+	var err error
+
+	if path := c.String("file"); path != "" {
+		result, err = emoji.LoadGemojiFile(path)
+	} else {
+		// Progress goes to stderr so the report itself can be piped
+		fmt.Fprintln(os.Stderr, "Fetching emoji data from GitHub gemoji repository...")
+		result, err = emoji.ScrapeGitHubEmojis()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to load emoji data: %w", err)
+	}
+
+	report := emoji.DiffEmojiMaps(emoji.EmojiMap, result.Data)
+
+	if c.Bool("json") {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+	} else if err := report.WriteText(os.Stdout); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	if len(report.Removed) > 0 && !c.Bool("allow-removals") {
+		return cli.Exit(fmt.Sprintf("%d aliases would be removed", len(report.Removed)), 1)
+	}
+
+	return nil
+}
+
+// unicodeAction ingests emoji-test.txt and writes the generated Go source
+func unicodeAction(ctx context.Context, c *cli.Command) error {
+	entries, err := emoji.LoadEmojiTestFile(c.String("file"))
+	if err != nil {
+		return err
+	}
+
+	if err := emoji.AssignAliases(entries, emoji.EmojiMap); err != nil {
+		return fmt.Errorf("failed to assign aliases: %w", err)
+	}
+
+	version := emoji.LatestVersion(entries)
+	goCode := emoji.GenerateUnicodeGoCode(entries, version)
+
+	formatted, err := format.Source([]byte(goCode))
+	if err != nil {
+		return fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	dataFile := c.String("output")
+	if err := os.WriteFile(dataFile, formatted, 0o644); err != nil {
+		return fmt.Errorf("failed to write data file: %w", err)
+	}
+
+	fmt.Printf("Generated %s with %d Unicode %s emoji sequences\n", dataFile, len(entries), version)
+
+	return nil
+}
+
+// cldrAction ingests CLDR annotations and writes the generated Go source
+func cldrAction(ctx context.Context, c *cli.Command) error {
+	version, err := emoji.CLDRVersion(c.String("dir"))
+	if err != nil {
+		return err
+	}
+
+	locales := make(map[string][]emoji.LocaleEntry)
+	total := 0
+
+	for _, code := range c.StringSlice("locale") {
+		entries, err := emoji.LoadCLDRLocale(c.String("dir"), code)
+		if err != nil {
+			return fmt.Errorf("failed to load locale %s: %w", code, err)
+		}
+
+		locales[code] = entries
+		total += len(entries)
+	}
+
+	goCode := emoji.GenerateLocaleGoCode(locales, version)
+
+	formatted, err := format.Source([]byte(goCode))
+	if err != nil {
+		return fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	dataFile := c.String("output")
+	if err := os.WriteFile(dataFile, formatted, 0o644); err != nil {
+		return fmt.Errorf("failed to write data file: %w", err)
+	}
+
+	fmt.Printf("Generated %s with %d entries in %d locales from CLDR %s\n", dataFile, total, len(locales), version)
+
+	return nil
+}
+
+// versionsAction ingests gemoji's Unicode versions and writes the generated Go source
+func versionsAction(ctx context.Context, c *cli.Command) error {
+	var result *emoji.ScraperResult
+	var err error
+
+	if path := c.String("file"); path != "" {
+		result, err = emoji.LoadGemojiFile(path)
+	} else {
+		fmt.Println("Fetching emoji data from GitHub gemoji repository...")
+		result, err = emoji.ScrapeGitHubEmojis()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to load emoji data: %w", err)
+	}
+
+	goCode := emoji.GenerateVersionsGoCode(result.Versions)
+
+	formatted, err := format.Source([]byte(goCode))
+	if err != nil {
+		return fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	dataFile := c.String("output")
+	if err := os.WriteFile(dataFile, formatted, 0o644); err != nil {
+		return fmt.Errorf("failed to write data file: %w", err)
+	}
+
+	fmt.Printf("Generated %s with the versions of %d emoji\n", dataFile, len(result.Versions))
+
+	return nil
 }
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/damienbutt/emojify-go/internal/bindings"
+	"github.com/damienbutt/emojify-go/internal/config"
+)
+
+// main is the WASI entry point, built with GOOS=wasip1 GOARCH=wasm
+//
+//	emojify.wasm encode [flags] < input
+//	emojify.wasm decode < input
+//	emojify.wasm search QUERY [LIMIT]
+//
+// encode and decode convert stdin to stdout; search prints a JSON array.
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "emojify:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes a single command
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("expected a command: encode, decode or search")
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	skinTone := flags.String("skin-tone", "", "default skin `TONE`: 1-5 or a name")
+	gender := flags.String("gender", "", "default `GENDER`: neutral, female or male")
+	presentation := flags.String("presentation", "", "force `MODE`: emoji, text or strip")
+	maxUnicode := flags.String("max-unicode", "", "only encode emoji from Unicode `VERSION` or earlier")
+	conventional := flags.Bool("conventional", false, "prefix conventional commit subjects with a gitmoji")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "encode":
+		input, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+
+		result, err := bindings.Encode(string(input), bindings.Options{
+			SkinTone:     config.StringOrNumber(*skinTone),
+			Gender:       *gender,
+			Presentation: *presentation,
+			MaxUnicode:   *maxUnicode,
+			Conventional: *conventional,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(stdout, result)
+		return err
+	case "decode":
+		input, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(stdout, bindings.Decode(string(input)))
+		return err
+	case "search":
+		if flags.NArg() == 0 || flags.NArg() > 2 {
+			return fmt.Errorf("usage: search QUERY [LIMIT]")
+		}
+
+		limit := 0
+		if flags.NArg() == 2 {
+			parsed, err := strconv.Atoi(flags.Arg(1))
+			if err != nil {
+				return fmt.Errorf("invalid limit %q", flags.Arg(1))
+			}
+
+			limit = parsed
+		}
+
+		results, err := bindings.Search(flags.Arg(0), limit)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(stdout, results)
+		return err
+	default:
+		return fmt.Errorf("unknown command %q (expected encode, decode or search)", args[0])
+	}
+}
@@ -0,0 +1,98 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/damienbutt/emojify-go/internal/bindings"
+	"github.com/damienbutt/emojify-go/internal/version"
+)
+
+// main registers the emojify object on the JavaScript global scope and waits for calls
+//
+//	emojify.encode(text, {skinTone: 3, presentation: "emoji"})
+//	emojify.decode(text)
+//	emojify.search(query, limit)
+//
+// Invalid arguments return an Error instead of a result.
+func main() {
+	js.Global().Set("emojify", js.ValueOf(map[string]any{
+		"encode":  js.FuncOf(encode),
+		"decode":  js.FuncOf(decode),
+		"search":  js.FuncOf(search),
+		"version": version.Version,
+	}))
+
+	select {}
+}
+
+// encode converts aliases to emoji, with an optional options object
+func encode(_ js.Value, args []js.Value) any {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return jsError("encode expects a string")
+	}
+
+	opts, err := bindings.ParseOptions(stringify(argument(args, 1)))
+	if err != nil {
+		return jsError(err.Error())
+	}
+
+	result, err := bindings.Encode(args[0].String(), opts)
+	if err != nil {
+		return jsError(err.Error())
+	}
+
+	return result
+}
+
+// decode converts emoji to aliases
+func decode(_ js.Value, args []js.Value) any {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return jsError("decode expects a string")
+	}
+
+	return bindings.Decode(args[0].String())
+}
+
+// search returns an array of matching emoji, with an optional result limit
+func search(_ js.Value, args []js.Value) any {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return jsError("search expects a string")
+	}
+
+	limit := 0
+	if value := argument(args, 1); value.Type() == js.TypeNumber {
+		limit = value.Int()
+	}
+
+	results, err := bindings.Search(args[0].String(), limit)
+	if err != nil {
+		return jsError(err.Error())
+	}
+
+	return js.Global().Get("JSON").Call("parse", results)
+}
+
+// argument returns args[i], or undefined if it wasn't passed
+func argument(args []js.Value, i int) js.Value {
+	if i < len(args) {
+		return args[i]
+	}
+
+	return js.Undefined()
+}
+
+// stringify converts a JavaScript value to JSON, giving "" for undefined and null
+func stringify(value js.Value) string {
+	if value.IsUndefined() || value.IsNull() {
+		return ""
+	}
+
+	return js.Global().Get("JSON").Call("stringify", value).String()
+}
+
+// jsError creates a JavaScript Error with the given message
+func jsError(message string) js.Value {
+	return js.Global().Get("Error").New("emojify: " + message)
+}
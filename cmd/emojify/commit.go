@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/config"
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// commitCommand runs git commit with a gitmoji-prefixed conventional commit message
+func commitCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "commit",
+		Usage:     "git commit with a gitmoji for the conventional commit type",
+		ArgsUsage: "[-- GIT_COMMIT_ARGS...]",
+		Description: `Builds a conventional commit message, prefixes it with the gitmoji for its
+type, encodes any aliases and runs git commit. Arguments after the flags are
+passed to git commit.
+
+Examples:
+  emojify commit -m "feat(api): add search"         # ✨ feat(api): add search
+  emojify commit -t fix -s parser -m "handle :x:"   # 🐛 fix(parser): handle ❌
+  emojify commit -m "docs: update README" -- --amend
+  emojify commit --list-types
+
+Override the gitmoji for a type in the config file:
+  {"commit_types": {"chore": ":hammer:", "deps": ":arrow_up:"}}`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "message",
+				Aliases: []string{"m"},
+				Usage:   "commit `MESSAGE`, optionally starting with a conventional commit type",
+			},
+			&cli.StringFlag{
+				Name:    "type",
+				Aliases: []string{"t"},
+				Usage:   "conventional commit `TYPE` to add to the message, such as feat or fix",
+			},
+			&cli.StringFlag{
+				Name:    "scope",
+				Aliases: []string{"s"},
+				Usage:   "conventional commit `SCOPE` for --type",
+			},
+			&cli.BoolFlag{
+				Name:    "breaking",
+				Aliases: []string{"b"},
+				Usage:   "mark the --type commit as a breaking change (feat!:)",
+			},
+			&cli.BoolFlag{
+				Name:    "dry-run",
+				Aliases: []string{"n"},
+				Usage:   "print the message instead of committing",
+			},
+			&cli.BoolFlag{
+				Name:  "list-types",
+				Usage: "list conventional commit types and their gitmoji",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			cfg, err := config.Load(c.String("config"))
+			if err != nil {
+				return err
+			}
+
+			types, err := commitTypes(cfg)
+			if err != nil {
+				return err
+			}
+
+			if c.Bool("list-types") {
+				return listCommitTypes(types)
+			}
+
+			message, err := commitMessage(c, types)
+			if err != nil {
+				return err
+			}
+
+			processor, err := newProcessor(c, emojify.WithCommitTypes(types))
+			if err != nil {
+				return err
+			}
+
+			message = processor.Process(message)
+
+			if c.Bool("dry-run") {
+				fmt.Println(message)
+				return nil
+			}
+
+			git := exec.CommandContext(ctx, "git", append([]string{"commit", "-m", message}, c.Args().Slice()...)...)
+			git.Stdin, git.Stdout, git.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+			if err := git.Run(); err != nil {
+				return fmt.Errorf("git commit failed: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// commitMessage builds the commit message from --message, --type, --scope and --breaking
+func commitMessage(c *cli.Command, types map[string]string) (string, error) {
+	message := strings.TrimSpace(c.String("message"))
+	if message == "" {
+		return "", fmt.Errorf("a commit message is required (use -m)")
+	}
+
+	commitType := strings.ToLower(c.String("type"))
+	if commitType == "" {
+		if c.String("scope") != "" || c.Bool("breaking") {
+			return "", fmt.Errorf("--scope and --breaking require --type")
+		}
+
+		return message, nil
+	}
+
+	if _, ok := types[commitType]; !ok {
+		return "", fmt.Errorf("unknown commit type %q (expected one of %s)", commitType, strings.Join(slices.Sorted(maps.Keys(types)), ", "))
+	}
+
+	prefix := commitType
+	if scope := c.String("scope"); scope != "" {
+		prefix += "(" + scope + ")"
+	}
+
+	if c.Bool("breaking") {
+		prefix += "!"
+	}
+
+	return prefix + ": " + message, nil
+}
+
+// commitTypes returns the default conventional commit types merged with the config overrides
+func commitTypes(cfg *config.Config) (map[string]string, error) {
+	types := emoji.DefaultCommitTypes()
+
+	for commitType, value := range cfg.CommitTypes {
+		if strings.HasPrefix(value, ":") && emoji.GetEmoji(value) == value {
+			return nil, fmt.Errorf("invalid commit_types entry %q: unknown alias %s", commitType, value)
+		}
+
+		types[strings.ToLower(commitType)] = value
+	}
+
+	return types, nil
+}
+
+// listCommitTypes prints each commit type with its gitmoji and meaning
+func listCommitTypes(types map[string]string) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	for _, commitType := range slices.Sorted(maps.Keys(types)) {
+		value := types[commitType]
+		if value == "" {
+			continue
+		}
+
+		alias, description := value, ""
+		if strings.HasPrefix(value, ":") {
+			value = emoji.GetEmoji(alias)
+		} else {
+			alias = emoji.GetAlias(value)
+		}
+
+		if gitmoji, ok := emoji.LookupGitmoji(value); ok {
+			description = gitmoji.Description
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", commitType, value, alias, description)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/config"
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// completionFlag is the flag cli appends to request completions, after the word being completed
+const completionFlag = "--generate-shell-completion"
+
+// completionScripts holds the completion script of each shell, where {{name}} is the program name
+//
+// Every script runs the program with the words typed so far, the word being
+// completed and completionFlag, and offers the lines it prints. Aliases are
+// printed as "alias<TAB>emoji" so that shells with descriptions show a preview.
+var completionScripts = map[string]string{
+	"bash": `# bash completion for {{name}}, generated by "{{name}} completion bash"
+
+_{{name}}_completion() {
+  local line=${COMP_LINE:0:COMP_POINT} rest word opt i
+  local -a words=()
+
+  # Bash splits words at colons too, so join the words no whitespace separates
+  for ((i = 0; i <= COMP_CWORD; i++)); do
+    rest=${line#"${line%%[![:space:]]*}"}
+    word=${COMP_WORDS[i]}
+    ((i == COMP_CWORD)) && word=$rest
+    if ((i > 0)) && [[ "$rest" == "$line" ]]; then
+      words[${#words[@]}-1]+=$word
+    else
+      words+=("$word")
+    fi
+    line=${rest#"$word"}
+  done
+
+  local cur=${words[${#words[@]}-1]}
+  COMPREPLY=()
+  while IFS= read -r opt; do
+    opt=${opt%%$'\t'*}
+    [[ -n "$opt" && "$opt" == "$cur"* ]] && COMPREPLY+=("$opt")
+  done < <("${words[@]:0:${#words[@]}-1}" "$cur" ` + completionFlag + ` 2>/dev/null)
+
+  # Replies must leave out the text before the last colon, where bash split the word
+  if [[ "$cur" == *:* && "$COMP_WORDBREAKS" == *:* ]]; then
+    local prefix=${cur%"${cur##*:}"}
+    COMPREPLY=("${COMPREPLY[@]#"$prefix"}")
+  fi
+}
+
+complete -o bashdefault -o default -F _{{name}}_completion {{name}}
+`,
+
+	"zsh": `#compdef {{name}}
+
+# zsh completion for {{name}}, generated by "{{name}} completion zsh"
+
+_{{name}}() {
+  local -a lines opts
+  local current=${words[CURRENT]} line
+  lines=("${(@f)$(${words[@]:0:CURRENT-1} "$current" ` + completionFlag + ` 2>/dev/null)}")
+
+  if [[ "$current" == :* ]]; then
+    # Describe each alias with its emoji, escaping the colons _describe splits on
+    for line in $lines; do
+      opts+=("${${line%%$'\t'*}//:/\\:}:${line#*$'\t'}")
+    done
+    _describe -t aliases 'aliases' opts
+  elif [[ -n "${lines[1]}" ]]; then
+    _describe 'values' lines
+  else
+    _files
+  fi
+}
+
+if [ "$funcstack[1]" = "_{{name}}" ]; then
+  _{{name}} "$@"
+else
+  compdef _{{name}} {{name}}
+fi
+`,
+
+	"fish": `{{commands}}
+# Complete :aliases, described by their emoji
+function __fish_{{name}}_complete_aliases
+    {{name}} (commandline -opc)[2..-1] (commandline -ct) ` + completionFlag + ` 2>/dev/null
+end
+
+complete -c {{name}} -n 'string match -q -- ":*" (commandline -ct)' -f -a '(__fish_{{name}}_complete_aliases)'
+`,
+
+	"powershell": `# PowerShell completion for {{name}}, generated by "{{name}} completion powershell"
+
+Register-ArgumentCompleter -Native -CommandName '{{name}}' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+
+    $arguments = @($commandAst.CommandElements | Select-Object -Skip 1 | ForEach-Object { $_.ToString() })
+
+    & '{{name}}' @arguments ` + completionFlag + ` 2>$null | ForEach-Object {
+        $value, $description = $_ -split [char]9, 2
+        if ($value -like "$wordToComplete*") {
+            if (-not $description) { $description = $value }
+            [System.Management.Automation.CompletionResult]::new($value, $value, 'ParameterValue', $description)
+        }
+    }
+}
+`,
+}
+
+// configureCompletionCommand replaces the script printed by cli's completion command with ours
+//
+// cli still answers the completion requests the scripts make, calling the
+// ShellComplete function of the command being completed.
+func configureCompletionCommand(cmd *cli.Command) {
+	cmd.Hidden = false
+	cmd.Usage = "print the shell completion script for bash, zsh, fish or powershell"
+	cmd.ArgsUsage = "SHELL"
+	cmd.Description = `The script completes flags and subcommands, and words starting with a colon
+to aliases, including localized aliases and custom emoji from the config
+file. zsh, fish and PowerShell show the emoji of each alias.
+
+Examples:
+  source <(emojify completion bash)                       # ~/.bashrc
+  source <(emojify completion zsh)                        # ~/.zshrc
+  emojify completion fish > ~/.config/fish/completions/emojify.fish
+  emojify completion powershell | Out-String | Invoke-Expression  # $PROFILE`
+	cmd.Action = func(ctx context.Context, c *cli.Command) error {
+		if c.Args().Len() != 1 {
+			return fmt.Errorf("expected a shell: %s", strings.Join(completionShells(), ", "))
+		}
+
+		shell := c.Args().First()
+		script, ok := completionScripts[shell]
+		if !ok {
+			return fmt.Errorf("unknown shell %q (available: %s)", shell, strings.Join(completionShells(), ", "))
+		}
+
+		root := c.Root()
+
+		commands := ""
+		if strings.Contains(script, "{{commands}}") {
+			var err error
+			if commands, err = root.ToFishCompletion(); err != nil {
+				return fmt.Errorf("failed to generate fish completion: %w", err)
+			}
+		}
+
+		_, err := fmt.Fprint(root.Writer, strings.NewReplacer("{{name}}", root.Name, "{{commands}}", commands).Replace(script))
+		return err
+	}
+}
+
+// completionShells returns the shells a completion script is available for, sorted
+func completionShells() []string {
+	shells := make([]string, 0, len(completionScripts))
+	for shell := range completionScripts {
+		shells = append(shells, shell)
+	}
+
+	sort.Strings(shells)
+
+	return shells
+}
+
+// completeAliases completes words starting with a colon to aliases, and anything else to flags and subcommands
+func completeAliases(ctx context.Context, c *cli.Command) {
+	word := completionWord()
+	if !strings.HasPrefix(word, ":") {
+		cli.DefaultCompleteWithFlags(ctx, c)
+		return
+	}
+
+	candidates, err := aliasCandidates(c)
+	if err != nil {
+		return
+	}
+
+	for _, candidate := range candidates {
+		if alias, _, _ := strings.Cut(candidate, "\t"); strings.HasPrefix(alias, word) {
+			fmt.Fprintln(c.Root().Writer, candidate)
+		}
+	}
+}
+
+// completionWord returns the word being completed, which the completion scripts pass just before completionFlag
+func completionWord() string {
+	if n := len(os.Args); n >= 3 && os.Args[n-1] == completionFlag {
+		return os.Args[n-2]
+	}
+
+	return ""
+}
+
+// aliasCandidates returns every alias the processor would encode as "alias<TAB>preview"
+//
+// Standard aliases come from emoji.ListAllEmojis, followed by the aliases
+// of the locale and the custom emoji selected by the flags and config file.
+func aliasCandidates(c *cli.Command) ([]string, error) {
+	cfg, err := config.Load(c.String("config"))
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	for _, entry := range emoji.ListAllEmojis() {
+		alias, preview, _ := strings.Cut(entry, " ")
+		candidates = append(candidates, alias+"\t"+preview)
+	}
+
+	locale, err := emojiLocale(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if locale != nil {
+		for _, entry := range locale.Entries {
+			candidates = append(candidates, entry.Alias+"\t"+entry.Emoji+" "+entry.Name)
+		}
+	}
+
+	images, err := customEmoji(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	custom := make([]string, 0, len(images))
+	for alias := range images {
+		custom = append(custom, alias+"\tcustom emoji")
+	}
+
+	sort.Strings(custom)
+
+	return append(candidates, custom...), nil
+}
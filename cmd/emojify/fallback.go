@@ -0,0 +1,46 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// terminalFallback writes aliases as text when encoding to a terminal whose locale can't display emoji
+//
+// It only applies when --fallback is not given, and not to HTML or escaped
+// output, which is meant for other programs.
+func terminalFallback(c *cli.Command) []emojify.Option {
+	if c.IsSet("fallback") || c.Bool("html") || c.IsSet("escape") {
+		return nil
+	}
+
+	if !isTerminal(os.Stdout) || localeIsUTF8() {
+		return nil
+	}
+
+	return []emojify.Option{emojify.WithFallback(emojify.FallbackText)}
+}
+
+// localeIsUTF8 reports whether the character encoding of the locale is UTF-8
+//
+// The first of LC_ALL, LC_CTYPE and LANG that is set decides, as in setlocale.
+// When none is set the encoding is unknown, as on Windows, and assumed to be UTF-8.
+func localeIsUTF8() bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := strings.ToLower(os.Getenv(name)); locale != "" {
+			return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+		}
+	}
+
+	return true
+}
+
+// isTerminal reports whether f is a terminal rather than a file or pipe
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/githook"
+)
+
+// gitCommand groups the git integration subcommands
+func gitCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "git",
+		Usage: "integrate with git commit messages",
+		Commands: []*cli.Command{
+			installHookCommand(),
+			uninstallHookCommand(),
+			runHookCommand(),
+		},
+	}
+}
+
+// installHookCommand installs a commit message hook in the current repository
+func installHookCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "install-hook",
+		Usage: "install a hook that emojifies commit messages in the current repository",
+		Description: `Modes:
+  encode   store commit messages with emoji (":rocket:" becomes "🚀")
+  decode   store commit messages with aliases ("🚀" becomes ":rocket:")
+  lint     reject commit messages containing unknown aliases
+
+An existing hook is kept and run before emojify. Running install-hook again
+changes the mode, and uninstall-hook restores the original hook.
+
+The hook runs this emojify binary, so reinstall it if the binary moves.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "mode",
+				Value: "encode",
+				Usage: "what the hook does: encode, decode or lint",
+			},
+			&cli.StringFlag{
+				Name:  "hook",
+				Value: "commit-msg",
+				Usage: "install as `HOOK`: commit-msg, or prepare-commit-msg to convert before the editor opens",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			mode, err := githook.ParseMode(c.String("mode"))
+			if err != nil {
+				return err
+			}
+
+			hook := c.String("hook")
+			if err := githook.ValidateHook(hook, mode); err != nil {
+				return err
+			}
+
+			executable, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("failed to locate the emojify binary: %w", err)
+			}
+
+			if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+				executable = resolved
+			}
+
+			hooksDir, err := githook.HooksDir(".")
+			if err != nil {
+				return err
+			}
+
+			if err := githook.Install(hooksDir, hook, mode, executable); err != nil {
+				return err
+			}
+
+			fmt.Printf("Installed %s hook (%s) in %s\n", hook, mode, hooksDir)
+			return nil
+		},
+	}
+}
+
+// uninstallHookCommand removes hooks installed by install-hook
+func uninstallHookCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "uninstall-hook",
+		Usage: "remove emojify hooks from the current repository, restoring any hook they chained",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "hook",
+				Usage: "only remove `HOOK` (default: every emojify hook)",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			hooks := githook.Hooks
+			if hook := c.String("hook"); hook != "" {
+				if !slices.Contains(githook.Hooks, hook) {
+					return fmt.Errorf("unsupported hook %q", hook)
+				}
+
+				hooks = []string{hook}
+			}
+
+			hooksDir, err := githook.HooksDir(".")
+			if err != nil {
+				return err
+			}
+
+			removed := 0
+			for _, hook := range hooks {
+				ok, err := githook.Uninstall(hooksDir, hook)
+				if errors.Is(err, githook.ErrNotInstalled) && !c.IsSet("hook") {
+					// Leave hooks that belong to the user or other tools alone
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				if ok {
+					fmt.Printf("Removed %s hook from %s\n", hook, hooksDir)
+					removed++
+				}
+			}
+
+			if removed == 0 {
+				fmt.Println("No emojify hooks installed")
+			}
+
+			return nil
+		},
+	}
+}
+
+// runHookCommand is the entry point called by installed hooks
+func runHookCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "run-hook",
+		Usage:     "process a commit message file (called by installed hooks)",
+		ArgsUsage: "FILE",
+		Hidden:    true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "mode",
+				Value: "encode",
+				Usage: "encode, decode or lint",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() != 1 {
+				return fmt.Errorf("expected the commit message file as the only argument")
+			}
+
+			mode, err := githook.ParseMode(c.String("mode"))
+			if err != nil {
+				return err
+			}
+
+			processor, err := newProcessor(c)
+			if err != nil {
+				return err
+			}
+
+			path := c.Args().First()
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read commit message: %w", err)
+			}
+
+			message := string(content)
+
+			// Hooks run at the top of the work tree, so this reads the repository's setting
+			commentChar, err := githook.CommentChar("")
+			if err != nil {
+				return err
+			}
+
+			switch mode {
+			case githook.ModeLint:
+				unknown := githook.UnknownAliases(message, commentChar, processor)
+				if len(unknown) == 0 {
+					return nil
+				}
+
+				for _, alias := range unknown {
+					if suggestion, ok := emoji.ClosestAlias(alias); ok {
+						fmt.Fprintf(os.Stderr, "emojify: unknown emoji alias %s (did you mean %s?)\n", alias, suggestion)
+					} else {
+						fmt.Fprintf(os.Stderr, "emojify: unknown emoji alias %s\n", alias)
+					}
+				}
+
+				return fmt.Errorf("commit message contains unknown emoji aliases")
+			case githook.ModeDecode:
+				message = githook.RewriteMessage(message, commentChar, processor.Decode)
+			default:
+				message = githook.RewriteMessage(message, commentChar, processor.Process)
+			}
+
+			if message == string(content) {
+				return nil
+			}
+
+			if err := os.WriteFile(path, []byte(message), 0o644); err != nil {
+				return fmt.Errorf("failed to write commit message: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+	"github.com/damienbutt/emojify-go/internal/gitfilter"
+)
+
+// gitFilterCommand runs a git long-running filter process
+func gitFilterCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "git-filter",
+		Usage: "convert files as git stages and checks them out (long-running filter process)",
+		Description: `Speaks git's long-running filter process protocol on stdin and stdout, so a
+single emojify process converts every file in a checkout or commit.
+
+Store files with aliases and check them out with emoji:
+  git config filter.emojify.process "emojify git-filter --clean decode --smudge encode"
+  git config filter.emojify.required true
+  echo '*.md filter=emojify' >> .gitattributes
+
+--clean converts files as they are staged and --smudge as they are checked
+out. Each takes encode, decode or none. Binary files are left untouched.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "clean",
+				Value: "decode",
+				Usage: "`MODE` for files being staged: encode, decode or none",
+			},
+			&cli.StringFlag{
+				Name:  "smudge",
+				Value: "encode",
+				Usage: "`MODE` for files being checked out: encode, decode or none",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			processor, err := newProcessor(c)
+			if err != nil {
+				return err
+			}
+
+			clean, err := filterMode(processor, "--clean", c.String("clean"))
+			if err != nil {
+				return err
+			}
+
+			smudge, err := filterMode(processor, "--smudge", c.String("smudge"))
+			if err != nil {
+				return err
+			}
+
+			if clean == nil && smudge == nil {
+				return fmt.Errorf("--clean and --smudge cannot both be none")
+			}
+
+			return gitfilter.New(clean, smudge).Run(os.Stdin, os.Stdout)
+		},
+	}
+}
+
+// filterMode returns the conversion for a --clean or --smudge mode, or nil for none
+func filterMode(processor *emojify.Processor, flag, mode string) (func(string) string, error) {
+	switch strings.ToLower(mode) {
+	case "encode":
+		return processor.Process, nil
+	case "decode":
+		return processor.Decode, nil
+	case "none", "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid %s mode %q (expected encode, decode or none)", flag, mode)
+	}
+}
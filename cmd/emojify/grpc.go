@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/damienbutt/emojify-go/internal/rpc"
+)
+
+// grpcCommand runs emojify as a gRPC server
+func grpcCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "grpc",
+		Usage: "serve the emojify.v1.EmojifyService gRPC API",
+		Description: `Serves Encode, Decode, Lookup, Search and the bidirectional Transform
+stream defined in proto/emojify/v1/emojify.proto. Server reflection is
+enabled, so tools such as grpcurl can discover the service.
+
+Example:
+  emojify grpc --addr :50051
+  grpcurl -plaintext -d '{"text": "Deploy :rocket:"}' localhost:50051 emojify.v1.EmojifyService/Encode`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "addr",
+				Value:   "localhost:50051",
+				Usage:   "listen on `ADDRESS`",
+				Sources: cli.EnvVars("EMOJIFY_GRPC_ADDR"),
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			processor, err := newProcessor(c)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", c.String("addr"))
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", c.String("addr"), err)
+			}
+
+			server := grpc.NewServer()
+			rpc.Register(server, processor)
+			reflection.Register(server)
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			logger := log.New(os.Stderr, "emojify: ", log.LstdFlags)
+			go func() {
+				<-ctx.Done()
+				logger.Printf("shutting down")
+				server.GracefulStop()
+			}()
+
+			logger.Printf("gRPC listening on %s", listener.Addr())
+
+			return server.Serve(listener)
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+	"github.com/damienbutt/emojify-go/internal/htmltext"
+	"github.com/damienbutt/emojify-go/internal/structured"
+)
+
+// inputConverter restricts convert to the text of the --input format
+//
+// The returned function fails when the input can't be parsed as the format.
+func inputConverter(c *cli.Command, convert func(string) string) (func(string) (string, error), error) {
+	input := strings.ToLower(c.String("input"))
+
+	if len(c.StringSlice("select")) > 0 && (input == "" || input == "text" || input == "html") {
+		return nil, fmt.Errorf("--select requires --input json, yaml or toml")
+	}
+
+	switch input {
+	case "", "text":
+		return func(text string) (string, error) {
+			return convert(text), nil
+		}, nil
+	case "html":
+		return func(document string) (string, error) {
+			return htmltext.Transform(document, convert), nil
+		}, nil
+	}
+
+	format, err := structured.ParseFormat(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --input format %q (expected text, html, json, yaml or toml)", c.String("input"))
+	}
+
+	selector, err := structured.ParseSelector(c.StringSlice("select")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(document string) (string, error) {
+		return structured.Transform(document, format, selector, convert)
+	}, nil
+}
+
+// isStructuredInput reports whether --input selects JSON, YAML or TOML
+func isStructuredInput(c *cli.Command) bool {
+	_, err := structured.ParseFormat(c.String("input"))
+	return err == nil
+}
+
+// usesBackslashEscape reports whether --escape writes backslash escapes, which re-quoting a string value would double
+func usesBackslashEscape(c *cli.Command) bool {
+	escape, err := emojify.ParseEscape(c.String("escape"))
+	return err == nil && escape != emojify.EscapeNone && escape != emojify.EscapeHTML
+}
+
+// isHTMLInput reports whether --input selects HTML
+func isHTMLInput(c *cli.Command) bool {
+	return strings.EqualFold(c.String("input"), "html")
+}
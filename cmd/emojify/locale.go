@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/config"
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// emojiLocale returns the dictionary selected by --locale, the config file or the environment
+//
+// English, the language of the standard aliases, and locales from the
+// environment without a dictionary give nil. A locale given explicitly must
+// have one.
+func emojiLocale(c *cli.Command, cfg *config.Config) (*emoji.Locale, error) {
+	name := cfg.Locale
+	if c.IsSet("locale") {
+		name = c.String("locale")
+	}
+
+	explicit := name != ""
+	if !explicit {
+		name = environmentLocale()
+	}
+
+	if isDefaultLocale(name) {
+		return nil, nil
+	}
+
+	locale, ok := emoji.FindLocale(name)
+	if !ok && explicit {
+		available := "none"
+		if codes := emoji.Locales(); len(codes) > 0 {
+			available = strings.Join(codes, ", ")
+		}
+
+		return nil, fmt.Errorf("unknown locale %q (available: %s)", name, available)
+	}
+
+	return locale, nil
+}
+
+// environmentLocale returns the locale for messages, from the first of LC_ALL, LC_MESSAGES and LANG that is set
+func environmentLocale() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			return locale
+		}
+	}
+
+	return ""
+}
+
+// isDefaultLocale reports whether a locale name means the standard English aliases
+func isDefaultLocale(name string) bool {
+	language := strings.ToLower(name)
+	if i := strings.IndexAny(language, "_-.@"); i >= 0 {
+		language = language[:i]
+	}
+
+	return language == "" || language == "en" || language == "c" || language == "posix"
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/lsp"
+	"github.com/damienbutt/emojify-go/internal/version"
+)
+
+// lspCommand runs the language server over stdio
+func lspCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "lsp",
+		Usage: "run a Language Server Protocol server over stdio",
+		Description: `Speaks LSP on stdin and stdout for editor integration. The server offers:
+  - completion of aliases after ":", with emoji previews
+  - hover details for the alias or emoji under the cursor
+  - warnings for unknown aliases, with a quick fix for likely typos
+  - code actions converting the selection between aliases and emoji
+
+Set the initialization option {"completionInsert": "emoji"} to insert emoji
+instead of aliases when accepting a completion.`,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "stdio",
+				Usage: "use stdin and stdout (the default; accepted for editor compatibility)",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			processor, err := newProcessor(c)
+			if err != nil {
+				return err
+			}
+
+			return lsp.NewServer(processor, version.Version).Run(os.Stdin, os.Stdout)
+		},
+	}
+}
@@ -10,6 +10,8 @@
 
 	"github.com/urfave/cli/v3"
 
+	"github.com/damienbutt/emojify-go/internal/config"
+	"github.com/damienbutt/emojify-go/internal/emoji"
 	"github.com/damienbutt/emojify-go/internal/emojify"
 	"github.com/damienbutt/emojify-go/internal/version"
 )
@@ -31,7 +33,23 @@ func main() {
   emojify --decode "Hey, I just 🙋 you!"
   git log --oneline --color | emojify | less -r
   echo "Perfect! :100:" | emojify
-  echo "Perfect! 💯" | emojify --decode`,
+  echo "Perfect! 💯" | emojify --decode
+  echo "Melting :melting_face:" | emojify --max-unicode 13.0
+  emojify --skin-tone 3 "Hi :wave:, or inline :wave::skin-tone-6: and :wave_tone1:"
+  git log --oneline | emojify --conventional
+  emojify --html "Deploy :rocket:" > status.html
+  emojify --input html < page.html > page.emoji.html
+  emojify --input yaml --select notes < release.yml
+  emojify --escape json ":rocket:"
+  kubectl get pods | emojify --fix-columns
+  emojify --fallback ascii "Thanks :slightly_smiling_face:"
+  emojify --emoticons "Thanks :) <3"
+  emojify --custom-emoji slack-emoji.json --markdown < export.txt
+  emojify --locale de "Start :rakete:"
+  source <(emojify completion bash)
+
+Defaults for --skin-tone and --gender can be set in ~/.config/emojify/config.json:
+  {"skin_tone": 3, "gender": "female"}`,
 
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
@@ -49,6 +67,112 @@ func main() {
 				Aliases: []string{"d"},
 				Usage:   "decode emoji to aliases",
 			},
+			&cli.StringFlag{
+				Name:  "max-unicode",
+				Usage: "only encode emoji available in Unicode `VERSION` or earlier (e.g. 13.0)",
+			},
+			&cli.StringFlag{
+				Name:  "unicode-fallback",
+				Usage: "`TEXT` to output for emoji newer than --max-unicode (default: keep the alias)",
+			},
+			&cli.StringFlag{
+				Name:  "presentation",
+				Usage: "force `MODE` for encoded emoji: emoji (VS16), text (VS15) or strip (no selectors)",
+			},
+			&cli.StringFlag{
+				Name:  "skin-tone",
+				Usage: "default skin `TONE` for emoji that support it: 1-5 or light, medium-light, medium, medium-dark, dark",
+			},
+			&cli.StringFlag{
+				Name:  "gender",
+				Usage: "default `GENDER` for emoji with gendered forms: neutral, female or male",
+			},
+			&cli.StringFlag{
+				Name:  "locale",
+				Usage: "also encode localized aliases such as :rakete:, and describe emoji in search and info, in `LOCALE` such as de or ja (default: $LC_ALL, $LC_MESSAGES or $LANG)",
+			},
+			&cli.BoolFlag{
+				Name:  "conventional",
+				Usage: "prefix conventional commit subjects with a gitmoji (feat: → ✨ feat:)",
+			},
+			&cli.StringFlag{
+				Name:  "input",
+				Value: "text",
+				Usage: "input `FORMAT`: text; html to convert only text outside tags, <script>, <style>, <code> and <pre>; or json, yaml or toml to convert only string values",
+			},
+			&cli.StringSliceFlag{
+				Name:  "select",
+				Usage: "with --input json, yaml or toml, only convert string values under `PATH`, a JSONPath such as $.releases[*].notes or a key such as notes (repeatable)",
+			},
+			&cli.BoolFlag{
+				Name:  "html",
+				Usage: "output HTML, rendering aliases as images or spans and escaping other text",
+			},
+			&cli.StringFlag{
+				Name:  "html-tag",
+				Usage: "render emoji in HTML output as `TAG`: img or span (text with an aria-label)",
+			},
+			&cli.StringFlag{
+				Name:  "html-url",
+				Usage: "image URL `TEMPLATE` for HTML output, where {{code}} is the image name (default: twemoji or Noto CDN)",
+			},
+			&cli.StringFlag{
+				Name:  "html-class",
+				Usage: "`CLASS` of emoji elements in HTML output (default: emoji)",
+			},
+			&cli.StringFlag{
+				Name:  "html-naming",
+				Usage: "image `NAMING` for HTML output: twemoji (1f44b-1f3fd) or noto (emoji_u1f44b_1f3fd)",
+			},
+			&cli.StringFlag{
+				Name:  "custom-emoji",
+				Usage: "read custom emoji such as :partyparrot: from `FILE`, a JSON object mapping aliases to image URLs",
+			},
+			&cli.BoolFlag{
+				Name:  "markdown",
+				Usage: "output Markdown, rendering custom emoji as images",
+			},
+			&cli.BoolFlag{
+				Name:  "emoticons",
+				Usage: "convert classic emoticons such as :) and <3 to emoji, and back to emoticons with --decode",
+			},
+			&cli.StringFlag{
+				Name:  "fallback",
+				Usage: "write aliases and emoji as plain text in `MODE` ascii (emoticons), text ([rocket]), strip, description ((rocket)) or none (default: text on a non-UTF-8 terminal)",
+			},
+			&cli.BoolFlag{
+				Name:  "fix-columns",
+				Usage: "re-pad whitespace-aligned columns after substitution so tables stay aligned",
+			},
+			&cli.StringFlag{
+				Name:  "escape",
+				Usage: "write emoji as ASCII escapes in `STYLE` json, go, python, html or css, and decode emoji written that way",
+			},
+			&cli.BoolFlag{
+				Name:  "decode-escapes",
+				Usage: "with --decode, also decode emoji written as &#128640;, &#x1F680;, \\u{1F680} or \\ud83d\\ude80 escapes",
+			},
+			&cli.StringFlag{
+				Name:    "config",
+				Usage:   "read defaults from config `FILE` (default: $XDG_CONFIG_HOME/emojify/config.json)",
+				Sources: cli.EnvVars("EMOJIFY_CONFIG"),
+			},
+		},
+
+		EnableShellCompletion:           true,
+		ConfigureShellCompletionCommand: configureCompletionCommand,
+		ShellComplete:                   completeAliases,
+
+		Commands: []*cli.Command{
+			serveCommand(),
+			grpcCommand(),
+			lspCommand(),
+			gitCommand(),
+			gitFilterCommand(),
+			pandocFilterCommand(),
+			commitCommand(),
+			searchCommand(),
+			infoCommand(),
 		},
 
 		Action: func(ctx context.Context, c *cli.Command) error {
@@ -60,6 +184,46 @@ func main() {
 				return fmt.Errorf("--encode and --decode flags are mutually exclusive")
 			}
 
+			if decodeFlag && c.Bool("html") {
+				return fmt.Errorf("--html only applies when encoding")
+			}
+
+			if !decodeFlag && c.Bool("decode-escapes") {
+				return fmt.Errorf("--decode-escapes only applies when decoding")
+			}
+
+			if decodeFlag && c.Bool("fix-columns") {
+				return fmt.Errorf("--fix-columns only applies when encoding")
+			}
+
+			if c.Bool("html") && c.IsSet("escape") {
+				return fmt.Errorf("--escape can't be combined with --html")
+			}
+
+			if !decodeFlag && isStructuredInput(c) && usesBackslashEscape(c) {
+				return fmt.Errorf("--escape %s can't be combined with --input %s, which quotes converted values itself", c.String("escape"), c.String("input"))
+			}
+
+			if decodeFlag && c.IsSet("fallback") {
+				return fmt.Errorf("--fallback only applies when encoding")
+			}
+
+			if c.Bool("html") && c.IsSet("fallback") {
+				return fmt.Errorf("--fallback can't be combined with --html")
+			}
+
+			if decodeFlag && c.Bool("markdown") {
+				return fmt.Errorf("--markdown only applies when encoding")
+			}
+
+			if c.Bool("html") && c.Bool("markdown") {
+				return fmt.Errorf("--markdown can't be combined with --html")
+			}
+
+			if c.Bool("html") && c.Bool("fix-columns") {
+				return fmt.Errorf("--fix-columns can't be combined with --html")
+			}
+
 			if c.Bool("list") {
 				return emojify.ListEmojis()
 			}
@@ -69,19 +233,37 @@ func main() {
 			// args.Slice() contains only non-flag arguments
 			hasArgs := len(args.Slice()) > 0
 
+			var extra []emojify.Option
+			if !decodeFlag {
+				extra = terminalFallback(c)
+			}
+
+			processor, err := newProcessor(c, extra...)
+			if err != nil {
+				return err
+			}
+
 			// Determine the processing function based on flags
 			var processFunc func(string) string
 			if decodeFlag {
-				processFunc = emojify.Decode
+				processFunc = processor.Decode
 			} else {
 				// Default behavior (encode) or explicit encode flag
-				processFunc = emojify.Process
+				processFunc = processor.Process
+			}
+
+			convert, err := inputConverter(c, processFunc)
+			if err != nil {
+				return err
 			}
 
 			if hasArgs {
 				// Process command line arguments
 				text := strings.Join(args.Slice(), " ")
-				processed := processFunc(text)
+				processed, err := convert(text)
+				if err != nil {
+					return err
+				}
 
 				// For empty processed text, don't add a newline for better pipeline compatibility
 				if processed == "" {
@@ -97,7 +279,11 @@ func main() {
 				}
 
 				// Process the input and preserve original format exactly
-				processed := processFunc(string(input))
+				processed, err := convert(string(input))
+				if err != nil {
+					return err
+				}
+
 				fmt.Print(processed)
 			}
 
@@ -114,3 +300,222 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// newProcessor creates a processor configured from the command line flags
+//
+// Options in extra are applied last, overriding the flags.
+func newProcessor(c *cli.Command, extra ...emojify.Option) (*emojify.Processor, error) {
+	cfg, err := config.Load(c.String("config"))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []emojify.Option
+
+	if c.String("max-unicode") != "" {
+		version, err := emoji.ParseVersion(c.String("max-unicode"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --max-unicode version %q", c.String("max-unicode"))
+		}
+
+		if !emoji.HasVersionData() {
+			return nil, fmt.Errorf("--max-unicode needs emoji version data, which this build leaves out")
+		}
+
+		opts = append(opts, emojify.WithMaxUnicodeVersion(version))
+	}
+
+	if c.IsSet("unicode-fallback") {
+		opts = append(opts, emojify.WithUnsupportedFallback(c.String("unicode-fallback")))
+	}
+
+	if c.IsSet("presentation") {
+		presentation, err := emoji.ParsePresentation(c.String("presentation"))
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, emojify.WithPresentation(presentation))
+	}
+
+	skinTone := string(cfg.SkinTone)
+	if c.IsSet("skin-tone") {
+		skinTone = c.String("skin-tone")
+	}
+
+	tone, err := emoji.ParseSkinTone(skinTone)
+	if err != nil {
+		return nil, err
+	}
+
+	gender := cfg.Gender
+	if c.IsSet("gender") {
+		gender = c.String("gender")
+	}
+
+	parsedGender, err := emoji.ParseGender(gender)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, emojify.WithSkinTone(tone), emojify.WithGender(parsedGender))
+
+	locale, err := emojiLocale(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if locale != nil {
+		opts = append(opts, emojify.WithLocale(locale))
+	}
+
+	// The config file turns prefixes on for text converted by emojify itself and for commit messages, not for filters and servers
+	conventional := cfg.ConventionalCommits && (c == c.Root() || c.Name == "run-hook")
+	if c.IsSet("conventional") {
+		conventional = c.Bool("conventional")
+	}
+
+	if conventional {
+		types, err := commitTypes(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, emojify.WithCommitTypes(types))
+	}
+
+	images, err := customEmoji(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if images != nil {
+		opts = append(opts, emojify.WithCustomEmoji(images))
+	}
+
+	if c.Bool("markdown") {
+		opts = append(opts, emojify.WithMarkdown())
+	}
+
+	if c.Bool("emoticons") {
+		table, err := emoticons(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		// HTML text writes emoticons such as <3 with character references, as &lt;3
+		if isHTMLInput(c) {
+			escape := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+			escaped := make(map[string]string, len(table))
+			for emoticon, value := range table {
+				escaped[escape.Replace(emoticon)] = value
+			}
+
+			table = escaped
+		}
+
+		opts = append(opts, emojify.WithEmoticons(table))
+	}
+
+	if c.IsSet("escape") {
+		escape, err := emojify.ParseEscape(c.String("escape"))
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, emojify.WithEscape(escape))
+	}
+
+	if c.IsSet("fallback") {
+		fallback, err := emojify.ParseFallback(c.String("fallback"))
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, emojify.WithFallback(fallback))
+	}
+
+	if c.Bool("fix-columns") {
+		opts = append(opts, emojify.WithFixColumns())
+	}
+
+	if c.Bool("decode-escapes") {
+		opts = append(opts, emojify.WithDecodeEscapes())
+	}
+
+	if c.Bool("html") {
+		option, err := htmlOption(c, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, option)
+	}
+
+	opts = append(opts, extra...)
+
+	return emojify.NewProcessor(opts...), nil
+}
+
+// customEmoji reads the custom emoji registry given by --custom-emoji or the config file, if any
+func customEmoji(c *cli.Command, cfg *config.Config) (map[string]string, error) {
+	path := cfg.CustomEmoji
+	if c.IsSet("custom-emoji") {
+		path = c.String("custom-emoji")
+	}
+
+	if path == "" {
+		return nil, nil
+	}
+
+	return config.LoadCustomEmoji(path)
+}
+
+// emoticons returns the default emoticons merged with the config overrides
+func emoticons(cfg *config.Config) (map[string]string, error) {
+	table := emoji.DefaultEmoticons()
+
+	for emoticon, value := range cfg.Emoticons {
+		if strings.HasPrefix(value, ":") && emoji.GetEmoji(value) == value {
+			return nil, fmt.Errorf("invalid emoticons entry %q: unknown alias %s", emoticon, value)
+		}
+
+		table[emoticon] = value
+	}
+
+	return table, nil
+}
+
+// htmlOption configures HTML output from the command line flags, falling back to the config file
+func htmlOption(c *cli.Command, cfg *config.Config) (emojify.Option, error) {
+	setting := func(flag, configured string) string {
+		if c.IsSet(flag) {
+			return c.String(flag)
+		}
+
+		return configured
+	}
+
+	tag, err := emojify.ParseHTMLTag(setting("html-tag", cfg.HTMLTag))
+	if err != nil {
+		return nil, err
+	}
+
+	naming, err := emoji.ParseImageNaming(setting("html-naming", cfg.HTMLNaming))
+	if err != nil {
+		return nil, err
+	}
+
+	url := setting("html-url", cfg.HTMLURL)
+	if url != "" && !strings.Contains(url, emojify.HTMLCodePlaceholder) {
+		return nil, fmt.Errorf("HTML image URL %q must contain %s", url, emojify.HTMLCodePlaceholder)
+	}
+
+	return emojify.WithHTML(emojify.HTMLOptions{
+		Tag:        tag,
+		URL:        url,
+		Class:      setting("html-class", cfg.HTMLClass),
+		Naming:     naming,
+		TextIsHTML: isHTMLInput(c),
+	}), nil
+}
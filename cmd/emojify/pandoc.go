@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/pandoc"
+)
+
+// pandocFilterCommand converts the text of a pandoc JSON AST
+func pandocFilterCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "pandoc-filter",
+		Usage:     "convert the text of a pandoc JSON document, leaving code and raw blocks untouched",
+		ArgsUsage: "[FORMAT]",
+		Description: `Reads pandoc's JSON AST on stdin and writes it back with aliases in text
+converted to emoji, or emoji converted to aliases with --decode:
+  pandoc -t json handbook.md | emojify pandoc-filter | pandoc -f json -o handbook.html
+
+pandoc's --filter option runs a program without arguments, so use a wrapper
+script for it:
+  printf '#!/bin/sh\nexec emojify pandoc-filter "$@"\n' > emojify-filter
+  chmod +x emojify-filter
+  pandoc --filter ./emojify-filter handbook.md -o handbook.html
+
+The output format pandoc passes as the first argument is ignored.`,
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() > 1 {
+				return fmt.Errorf("expected at most one argument, the output format")
+			}
+
+			processor, err := newProcessor(c)
+			if err != nil {
+				return err
+			}
+
+			convert := processor.Process
+			if c.Bool("decode") {
+				convert = processor.Decode
+			}
+
+			return pandoc.Filter(os.Stdin, os.Stdout, convert)
+		},
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/config"
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// searchCommand finds emoji by alias, name or gitmoji meaning
+func searchCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "search",
+		Usage:     "find emoji by alias, name or gitmoji meaning",
+		ArgsUsage: "QUERY...",
+		Description: `Every word of the query must appear in an alias, the Unicode name or the
+gitmoji description. Exact and prefix alias matches are listed first. With
+--locale, or a locale from the environment, localized aliases, names and
+keywords match too, and results are described in that language.
+
+Examples:
+  emojify search rocket
+  emojify search fix bug
+  emojify search --json cat face
+  emojify search --locale de rakete`,
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  "limit",
+				Value: 20,
+				Usage: "show at most `N` results (0 for all)",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "print results as JSON",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			query := strings.Join(c.Args().Slice(), " ")
+			if strings.TrimSpace(query) == "" {
+				return fmt.Errorf("a search query is required")
+			}
+
+			locale, err := commandLocale(c)
+			if err != nil {
+				return err
+			}
+
+			var results []emoji.Info
+			if locale != nil {
+				results = locale.Search(query, c.Int("limit"))
+			} else {
+				results = emoji.Search(query, c.Int("limit"))
+			}
+
+			if c.Bool("json") {
+				if results == nil {
+					results = []emoji.Info{}
+				}
+
+				return printJSON(results)
+			}
+
+			if len(results) == 0 {
+				return fmt.Errorf("no emoji found for %q", query)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			for _, info := range results {
+				description := info.Name
+				if info.LocalName != "" {
+					description = info.LocalName
+				}
+
+				if info.Gitmoji != "" {
+					description += " (gitmoji: " + info.Gitmoji + ")"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\n", info.Emoji, info.Alias, description)
+			}
+
+			return w.Flush()
+		},
+	}
+}
+
+// infoCommand describes a single alias or emoji
+func infoCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "info",
+		Usage:     "show details of an alias or emoji",
+		ArgsUsage: "ALIAS|EMOJI",
+		// Complete the alias to look up like text to encode
+		ShellComplete: completeAliases,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "print details as JSON",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() != 1 {
+				return fmt.Errorf("expected a single alias or emoji")
+			}
+
+			locale, err := commandLocale(c)
+			if err != nil {
+				return err
+			}
+
+			query := c.Args().First()
+
+			var info emoji.Info
+			var ok bool
+			if locale != nil {
+				info, ok = locale.Lookup(query)
+			} else {
+				info, ok = emoji.Lookup(query)
+			}
+
+			if !ok {
+				if suggestion, found := emoji.ClosestAlias(query); found && !emoji.HasEmojiCharacters(query) {
+					return fmt.Errorf("unknown emoji %q (did you mean %s?)", query, suggestion)
+				}
+
+				return fmt.Errorf("unknown emoji %q", query)
+			}
+
+			if c.Bool("json") {
+				return printJSON(info)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "Emoji:\t%s\n", info.Emoji)
+			fmt.Fprintf(w, "Alias:\t%s\n", info.Alias)
+			fmt.Fprintf(w, "Aliases:\t%s\n", strings.Join(info.Aliases, " "))
+
+			for _, field := range []struct{ label, value string }{
+				{"Name:", info.Name},
+				{"Group:", info.Group},
+				{"Subgroup:", info.Subgroup},
+				{"Unicode:", info.UnicodeVersion},
+				{"Codepoints:", info.Codepoints},
+				{"Width:", strconv.Itoa(info.Width)},
+				{"Gitmoji:", info.Gitmoji},
+				{"Local alias:", info.LocalAlias},
+				{"Local name:", info.LocalName},
+				{"Keywords:", strings.Join(info.Keywords, ", ")},
+			} {
+				if field.value != "" {
+					fmt.Fprintf(w, "%s\t%s\n", field.label, field.value)
+				}
+			}
+
+			return w.Flush()
+		},
+	}
+}
+
+// commandLocale returns the locale dictionary for search and info, or nil for English
+func commandLocale(c *cli.Command) (*emoji.Locale, error) {
+	cfg, err := config.Load(c.String("config"))
+	if err != nil {
+		return nil, err
+	}
+
+	return emojiLocale(c, cfg)
+}
+
+// printJSON writes value to stdout as indented JSON
+func printJSON(value any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
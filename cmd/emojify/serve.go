@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/damienbutt/emojify-go/internal/server"
+)
+
+// serveCommand runs emojify as an HTTP server
+func serveCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "serve",
+		Usage: "serve encode, decode and search over HTTP",
+		Description: `Endpoints:
+  POST /encode          convert aliases to emoji
+  POST /decode          convert emoji to aliases
+  GET  /emoji/{alias}   look up an alias or emoji
+  GET  /search?q=       search aliases and names (optional &limit=)
+  GET  /healthz         health check
+
+/encode and /decode accept a text/plain body, or {"text": "..."} with
+Content-Type: application/json, and reply in the same format.
+
+Example:
+  emojify serve --addr :8080
+  curl -d 'Deploy :rocket:' localhost:8080/encode`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "addr",
+				Value:   "localhost:8080",
+				Usage:   "listen on `ADDRESS`",
+				Sources: cli.EnvVars("EMOJIFY_ADDR"),
+			},
+			&cli.Int64Flag{
+				Name:  "max-body",
+				Value: server.DefaultMaxBodySize,
+				Usage: "reject request bodies larger than `BYTES`",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Int64("max-body") < 1 {
+				return fmt.Errorf("--max-body must be positive")
+			}
+
+			processor, err := newProcessor(c)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			srv := server.New(processor,
+				server.WithMaxBodySize(c.Int64("max-body")),
+				server.WithLogger(log.New(os.Stderr, "emojify: ", log.LstdFlags)),
+			)
+
+			return srv.ListenAndServe(ctx, c.String("addr"))
+		},
+	}
+}
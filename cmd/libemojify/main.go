@@ -0,0 +1,139 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/damienbutt/emojify-go/internal/bindings"
+	"github.com/damienbutt/emojify-go/internal/version"
+)
+
+// main is required by -buildmode=c-shared but never runs
+//
+//	go build -buildmode=c-shared -o libemojify.so ./cmd/libemojify
+//
+// The build also writes libemojify.h. Every string passed in is UTF-8 and
+// NUL-terminated, and remains owned by the caller. Every non-NULL string
+// returned is allocated by the library and must be released with
+// emojify_free.
+func main() {}
+
+// emojify_encode converts aliases in text to emoji with the default options.
+// Returns NULL if text is NULL. Free the result with emojify_free.
+//
+//export emojify_encode
+func emojify_encode(text *C.char) *C.char {
+	if text == nil {
+		return nil
+	}
+
+	result, err := bindings.Encode(C.GoString(text), bindings.Options{})
+	if err != nil {
+		return nil
+	}
+
+	return C.CString(result)
+}
+
+// emojify_encode_with_options converts aliases in text to emoji using a JSON
+// options object such as {"skinTone": 3, "presentation": "emoji"}; options may
+// be NULL. On failure it returns NULL and, if errmsg is not NULL, stores a
+// message in *errmsg. Free the result and the message with emojify_free.
+//
+//export emojify_encode_with_options
+func emojify_encode_with_options(text, options *C.char, errmsg **C.char) *C.char {
+	if text == nil {
+		return fail(errmsg, "text is NULL")
+	}
+
+	var data string
+	if options != nil {
+		data = C.GoString(options)
+	}
+
+	opts, err := bindings.ParseOptions(data)
+	if err != nil {
+		return fail(errmsg, err.Error())
+	}
+
+	result, err := bindings.Encode(C.GoString(text), opts)
+	if err != nil {
+		return fail(errmsg, err.Error())
+	}
+
+	return C.CString(result)
+}
+
+// emojify_decode converts emoji in text to aliases.
+// Returns NULL if text is NULL. Free the result with emojify_free.
+//
+//export emojify_decode
+func emojify_decode(text *C.char) *C.char {
+	if text == nil {
+		return nil
+	}
+
+	return C.CString(bindings.Decode(C.GoString(text)))
+}
+
+// emojify_lookup returns the details of an alias or emoji as a JSON object,
+// or NULL if it is unknown. Free the result with emojify_free.
+//
+//export emojify_lookup
+func emojify_lookup(query *C.char) *C.char {
+	if query == nil {
+		return nil
+	}
+
+	result, ok, err := bindings.Lookup(C.GoString(query))
+	if err != nil || !ok {
+		return nil
+	}
+
+	return C.CString(result)
+}
+
+// emojify_search returns the emoji matching query as a JSON array of at most
+// limit entries; a limit of 0 uses the default. Returns NULL if query is NULL.
+// Free the result with emojify_free.
+//
+//export emojify_search
+func emojify_search(query *C.char, limit C.int) *C.char {
+	if query == nil {
+		return nil
+	}
+
+	result, err := bindings.Search(C.GoString(query), int(limit))
+	if err != nil {
+		return nil
+	}
+
+	return C.CString(result)
+}
+
+// emojify_version returns the library version. Free the result with emojify_free.
+//
+//export emojify_version
+func emojify_version() *C.char {
+	return C.CString(version.Version)
+}
+
+// emojify_free releases a string returned by the library. NULL is ignored.
+//
+//export emojify_free
+func emojify_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+// fail stores message in *errmsg, if the caller asked for it, and returns NULL
+func fail(errmsg **C.char, message string) *C.char {
+	if errmsg != nil {
+		*errmsg = C.CString(message)
+	}
+
+	return nil
+}
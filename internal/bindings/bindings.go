@@ -0,0 +1,137 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/damienbutt/emojify-go/internal/config"
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// Options are the encoding options accepted by the WebAssembly and C library entry points
+//
+// They mirror the CLI flags of the same names.
+type Options struct {
+	SkinTone     config.StringOrNumber `json:"skinTone"`
+	Gender       string                `json:"gender"`
+	Presentation string                `json:"presentation"`
+	MaxUnicode   string                `json:"maxUnicode"`
+	Conventional bool                  `json:"conventional"`
+}
+
+// ParseOptions decodes options from a JSON object, as passed from JavaScript or C
+//
+// Empty input gives the default options.
+func ParseOptions(data string) (Options, error) {
+	var opts Options
+	if data == "" || data == "null" || data == "undefined" {
+		return opts, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &opts); err != nil {
+		return opts, fmt.Errorf("invalid options: %w", err)
+	}
+
+	return opts, nil
+}
+
+// NewProcessor creates a processor configured with opts
+//
+// Every binding shares this, so they convert text exactly like the CLI.
+func NewProcessor(opts Options) (*emojify.Processor, error) {
+	var processorOpts []emojify.Option
+
+	if opts.MaxUnicode != "" {
+		version, err := emoji.ParseVersion(opts.MaxUnicode)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxUnicode version %q", opts.MaxUnicode)
+		}
+
+		if !emoji.HasVersionData() {
+			return nil, fmt.Errorf("maxUnicode needs emoji version data, which this build leaves out")
+		}
+
+		processorOpts = append(processorOpts, emojify.WithMaxUnicodeVersion(version))
+	}
+
+	presentation, err := emoji.ParsePresentation(opts.Presentation)
+	if err != nil {
+		return nil, err
+	}
+
+	tone, err := emoji.ParseSkinTone(string(opts.SkinTone))
+	if err != nil {
+		return nil, err
+	}
+
+	gender, err := emoji.ParseGender(opts.Gender)
+	if err != nil {
+		return nil, err
+	}
+
+	processorOpts = append(processorOpts,
+		emojify.WithPresentation(presentation),
+		emojify.WithSkinTone(tone),
+		emojify.WithGender(gender),
+	)
+
+	if opts.Conventional {
+		processorOpts = append(processorOpts, emojify.WithCommitTypes(emoji.DefaultCommitTypes()))
+	}
+
+	return emojify.NewProcessor(processorOpts...), nil
+}
+
+// Encode converts aliases in text to emoji
+func Encode(text string, opts Options) (string, error) {
+	processor, err := NewProcessor(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return processor.Process(text), nil
+}
+
+// Decode converts emoji in text to aliases
+func Decode(text string) string {
+	return emojify.NewProcessor().Decode(text)
+}
+
+// Lookup returns the details of an alias or emoji as a JSON object, in the format of the HTTP API
+//
+// It reports false if the alias or emoji is unknown.
+func Lookup(query string) (string, bool, error) {
+	info, ok := emoji.Lookup(query)
+	if !ok {
+		return "", false, nil
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(data), true, nil
+}
+
+// Search returns the emoji matching query as a JSON array, in the format of the HTTP API
+//
+// A limit of zero or less uses emoji.DefaultSearchLimit.
+func Search(query string, limit int) (string, error) {
+	if limit <= 0 {
+		limit = emoji.DefaultSearchLimit
+	}
+
+	results := emoji.Search(query, limit)
+	if results == nil {
+		results = []emoji.Info{}
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
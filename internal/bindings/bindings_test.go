@@ -0,0 +1,147 @@
+package bindings
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// BindingsTestSuite defines the test suite for the shared binding entry points
+type BindingsTestSuite struct {
+	suite.Suite
+}
+
+// TestParseOptions tests decoding of options passed as JSON
+func (suite *BindingsTestSuite) TestParseOptions() {
+	tests := []struct {
+		name     string
+		input    string
+		expected Options
+	}{
+		{name: "empty", input: "", expected: Options{}},
+		{name: "null", input: "null", expected: Options{}},
+		{name: "undefined", input: "undefined", expected: Options{}},
+		{name: "numeric skin tone", input: `{"skinTone": 3}`, expected: Options{SkinTone: "3"}},
+		{
+			name:     "all options",
+			input:    `{"skinTone": "dark", "gender": "female", "presentation": "text", "maxUnicode": "12.0", "conventional": true}`,
+			expected: Options{SkinTone: "dark", Gender: "female", Presentation: "text", MaxUnicode: "12.0", Conventional: true},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			opts, err := ParseOptions(tt.input)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, opts)
+		})
+	}
+
+	_, err := ParseOptions(`{"skinTone": true}`)
+	assert.Error(suite.T(), err)
+}
+
+// TestEncode tests encoding with options
+func (suite *BindingsTestSuite) TestEncode() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		opts     Options
+		expected string
+	}{
+		{name: "default", input: "Ship it :rocket:", expected: "Ship it 🚀"},
+		{name: "skin tone", input: ":wave:", opts: Options{SkinTone: "3"}, expected: "👋🏽"},
+		{name: "text presentation", input: ":airplane:", opts: Options{Presentation: "text"}, expected: "✈\uFE0E"},
+		{name: "conventional", input: "feat: add search", opts: Options{Conventional: true}, expected: "✨ feat: add search"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := Encode(tt.input, tt.opts)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result)
+		})
+	}
+}
+
+// TestEncodeMaxUnicode tests that maxUnicode is refused by builds without version data
+func (suite *BindingsTestSuite) TestEncodeMaxUnicode() {
+	result, err := Encode("Melting :melting_face:", Options{MaxUnicode: "13.0"})
+
+	if emoji.HasVersionData() {
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "Melting :melting_face:", result)
+	} else {
+		assert.ErrorContains(suite.T(), err, "version data")
+	}
+}
+
+// TestEncodeInvalidOptions tests that invalid options are reported rather than ignored
+func (suite *BindingsTestSuite) TestEncodeInvalidOptions() {
+	for _, opts := range []Options{
+		{SkinTone: "9"},
+		{Gender: "unknown"},
+		{Presentation: "sideways"},
+		{MaxUnicode: "latest"},
+		{MaxUnicode: "NaN"},
+		{MaxUnicode: "1e3"},
+	} {
+		_, err := Encode(":rocket:", opts)
+		assert.Error(suite.T(), err, "Options %+v", opts)
+	}
+}
+
+// TestDecode tests converting emoji back to aliases
+func (suite *BindingsTestSuite) TestDecode() {
+	assert.Equal(suite.T(), "Ship it :rocket:", Decode("Ship it 🚀"))
+}
+
+// TestLookup tests that lookups return a JSON object
+func (suite *BindingsTestSuite) TestLookup() {
+	data, ok, err := Lookup(":rocket:")
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), ok)
+
+	var info emoji.Info
+	require.NoError(suite.T(), json.Unmarshal([]byte(data), &info))
+	assert.Equal(suite.T(), "🚀", info.Emoji)
+
+	_, ok, err = Lookup(":no_such_emoji:")
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+// TestSearch tests that search results are returned as a JSON array
+func (suite *BindingsTestSuite) TestSearch() {
+	data, err := Search("rocket", 0)
+	require.NoError(suite.T(), err)
+
+	var results []emoji.Info
+	require.NoError(suite.T(), json.Unmarshal([]byte(data), &results))
+	require.NotEmpty(suite.T(), results)
+	assert.Equal(suite.T(), "🚀", results[0].Emoji)
+
+	data, err = Search("rocket", 1)
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), json.Unmarshal([]byte(data), &results))
+	assert.Len(suite.T(), results, 1)
+
+	// No matches is an empty array rather than null
+	data, err = Search("no-such-emoji-anywhere", 0)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "[]", data)
+}
+
+// TestBindings runs all binding entry point tests
+func TestBindings(t *testing.T) {
+	suite.Run(t, new(BindingsTestSuite))
+}
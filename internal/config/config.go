@@ -0,0 +1,124 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// FileName is the name of the config file inside the emojify config directory
+const FileName = "config.json"
+
+// Config holds user preferences read from the emojify config file
+type Config struct {
+	// SkinTone is the default skin tone, as a number (1-5) or a name such as "medium"
+	SkinTone StringOrNumber `json:"skin_tone,omitempty"`
+	// Gender is the default gender for emoji with gendered forms: neutral, female or male
+	Gender string `json:"gender,omitempty"`
+	// Locale selects the language of localized aliases and names, such as "de" or "ja";
+	// by default it is taken from LC_ALL, LC_MESSAGES or LANG
+	Locale string `json:"locale,omitempty"`
+	// ConventionalCommits prefixes conventional commit subjects such as "feat: ..." with a gitmoji when encoding
+	ConventionalCommits bool `json:"conventional_commits,omitempty"`
+	// CommitTypes overrides the gitmoji alias or emoji for conventional commit types;
+	// an empty value disables the prefix for that type
+	CommitTypes map[string]string `json:"commit_types,omitempty"`
+	// Emoticons adds or overrides the alias or emoji of emoticons for --emoticons;
+	// an empty value disables that emoticon
+	Emoticons map[string]string `json:"emoticons,omitempty"`
+	// CustomEmoji is the path of a JSON file mapping custom emoji aliases to image URLs
+	CustomEmoji string `json:"custom_emoji,omitempty"`
+	// HTMLTag is the element emoji are rendered as with --html: img or span
+	HTMLTag string `json:"html_tag,omitempty"`
+	// HTMLURL is the image URL template for --html, where {{code}} is the image name
+	HTMLURL string `json:"html_url,omitempty"`
+	// HTMLClass is the class of emoji elements with --html
+	HTMLClass string `json:"html_class,omitempty"`
+	// HTMLNaming is the image naming scheme for --html: twemoji or noto
+	HTMLNaming string `json:"html_naming,omitempty"`
+}
+
+// StringOrNumber is a config value that may be written either as a JSON string or number
+type StringOrNumber string
+
+// UnmarshalJSON accepts both "3" and 3
+func (v *StringOrNumber) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+
+		*v = StringOrNumber(s)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("expected a string or number, got %s", data)
+	}
+
+	*v = StringOrNumber(number.String())
+	return nil
+}
+
+// DefaultPath returns the location of the config file, usually ~/.config/emojify/config.json
+//
+// $XDG_CONFIG_HOME is honoured on every platform.
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		var err error
+		if dir, err = os.UserConfigDir(); err != nil {
+			return "", fmt.Errorf("failed to find config directory: %w", err)
+		}
+	}
+
+	return filepath.Join(dir, "emojify", FileName), nil
+}
+
+// Load reads the config file at path
+//
+// An empty path loads the default config file, which is optional: if it does
+// not exist an empty Config is returned. A file given explicitly must exist.
+func Load(path string) (*Config, error) {
+	optional := path == ""
+	if optional {
+		var err error
+		if path, err = DefaultPath(); err != nil {
+			return &Config{}, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return &Config{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	return Parse(data, path)
+}
+
+// Parse decodes config file contents, using name in error messages
+func Parse(data []byte, name string) (*Config, error) {
+	cfg := &Config{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return cfg, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", name, err)
+	}
+
+	return cfg, nil
+}
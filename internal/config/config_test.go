@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// ConfigTestSuite defines the test suite for config loading
+type ConfigTestSuite struct {
+	suite.Suite
+}
+
+// TestParse tests decoding of config values
+func (suite *ConfigTestSuite) TestParse() {
+	tests := []struct {
+		name     string
+		input    string
+		expected Config
+	}{
+		{name: "empty file", input: "", expected: Config{}},
+		{name: "numeric skin tone", input: `{"skin_tone": 3}`, expected: Config{SkinTone: "3"}},
+		{name: "named skin tone", input: `{"skin_tone": "medium-dark", "gender": "female"}`, expected: Config{SkinTone: "medium-dark", Gender: "female"}},
+		{name: "locale", input: `{"locale": "de"}`, expected: Config{Locale: "de"}},
+		{
+			name:     "commit types",
+			input:    `{"conventional_commits": true, "commit_types": {"feat": ":rocket:", "chore": ""}}`,
+			expected: Config{ConventionalCommits: true, CommitTypes: map[string]string{"feat": ":rocket:", "chore": ""}},
+		},
+		{
+			name:     "emoticons",
+			input:    `{"emoticons": {"\\o/": ":raised_hands:", ":P": ""}}`,
+			expected: Config{Emoticons: map[string]string{`\o/`: ":raised_hands:", ":P": ""}},
+		},
+		{
+			name:     "custom emoji",
+			input:    `{"custom_emoji": "/etc/emojify/slack.json"}`,
+			expected: Config{CustomEmoji: "/etc/emojify/slack.json"},
+		},
+		{
+			name:     "HTML output",
+			input:    `{"html_tag": "span", "html_url": "/emoji/{{code}}.png", "html_class": "icon", "html_naming": "noto"}`,
+			expected: Config{HTMLTag: "span", HTMLURL: "/emoji/{{code}}.png", HTMLClass: "icon", HTMLNaming: "noto"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			cfg, err := Parse([]byte(tt.input), "test")
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, *cfg)
+		})
+	}
+}
+
+// TestParseErrors tests that invalid config files are rejected
+func (suite *ConfigTestSuite) TestParseErrors() {
+	for _, input := range []string{`{"skin_tone": true}`, `{"skintone": 3}`, `not json`} {
+		_, err := Parse([]byte(input), "test")
+		assert.Error(suite.T(), err, "Input %q", input)
+	}
+}
+
+// TestLoad tests reading explicit and default config files
+func (suite *ConfigTestSuite) TestLoad() {
+	dir := suite.T().TempDir()
+	suite.T().Setenv("XDG_CONFIG_HOME", dir)
+
+	// The default config file is optional
+	cfg, err := Load("")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), Config{}, *cfg)
+
+	path, err := DefaultPath()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), filepath.Join(dir, "emojify", FileName), path)
+
+	require.NoError(suite.T(), os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(suite.T(), os.WriteFile(path, []byte(`{"gender": "male"}`), 0o644))
+
+	cfg, err = Load("")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "male", cfg.Gender)
+
+	// An explicit config file must exist
+	_, err = Load(filepath.Join(dir, "missing.json"))
+	assert.Error(suite.T(), err)
+}
+
+// TestConfig runs all config tests
+func TestConfig(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
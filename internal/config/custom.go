@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// slackAliasPrefix marks an entry of a Slack emoji.list response that refers to another emoji
+const slackAliasPrefix = "alias:"
+
+// LoadCustomEmoji reads a custom emoji registry mapping aliases to image URLs
+func LoadCustomEmoji(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read custom emoji: %w", err)
+	}
+
+	return ParseCustomEmoji(data, path)
+}
+
+// ParseCustomEmoji decodes a custom emoji registry, using name in error messages
+//
+// The registry is a JSON object mapping aliases, with or without colons, to
+// image URLs: {"partyparrot": "https://example.com/partyparrot.gif"}. The
+// response of Slack's emoji.list API is accepted too; its aliases of other
+// custom emoji are resolved, and aliases of standard emoji are skipped.
+func ParseCustomEmoji(data []byte, name string) (map[string]string, error) {
+	var registry map[string]string
+
+	var slack struct {
+		Emoji map[string]string `json:"emoji"`
+	}
+
+	if err := json.Unmarshal(data, &slack); err == nil && slack.Emoji != nil {
+		registry = slack.Emoji
+	} else if err := json.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("invalid custom emoji %s: %w", name, err)
+	}
+
+	images := make(map[string]string, len(registry))
+	for alias, url := range registry {
+		alias = strings.Trim(alias, ":")
+		if alias == "" || strings.IndexFunc(alias, func(char rune) bool { return !emoji.IsValidEmojiChar(char) }) >= 0 {
+			return nil, fmt.Errorf("invalid custom emoji %s: %q is not a valid alias", name, alias)
+		}
+
+		if target, ok := strings.CutPrefix(url, slackAliasPrefix); ok {
+			if url, ok = registry[target]; !ok || strings.HasPrefix(url, slackAliasPrefix) {
+				continue
+			}
+		}
+
+		if url == "" {
+			return nil, fmt.Errorf("invalid custom emoji %s: no image URL for %q", name, alias)
+		}
+
+		images[":"+alias+":"] = url
+	}
+
+	return images, nil
+}
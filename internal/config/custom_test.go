@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// CustomEmojiTestSuite defines the test suite for custom emoji registries
+type CustomEmojiTestSuite struct {
+	suite.Suite
+}
+
+// TestParseCustomEmoji tests decoding of plain and Slack registries
+func (suite *CustomEmojiTestSuite) TestParseCustomEmoji() {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]string
+	}{
+		{
+			name:     "plain object",
+			input:    `{"partyparrot": "https://example.com/parrot.gif", ":blob-cat:": "/blobcat.png"}`,
+			expected: map[string]string{":partyparrot:": "https://example.com/parrot.gif", ":blob-cat:": "/blobcat.png"},
+		},
+		{
+			name:     "slack emoji.list",
+			input:    `{"ok": true, "emoji": {"parrot": "https://example.com/parrot.gif", "party": "alias:parrot", "yay": "alias:tada"}}`,
+			expected: map[string]string{":parrot:": "https://example.com/parrot.gif", ":party:": "https://example.com/parrot.gif"},
+		},
+		{
+			name:     "empty",
+			input:    `{}`,
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			images, err := ParseCustomEmoji([]byte(tt.input), "test")
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, images)
+		})
+	}
+}
+
+// TestParseErrors tests that invalid registries are rejected
+func (suite *CustomEmojiTestSuite) TestParseErrors() {
+	for _, input := range []string{`not json`, `["parrot"]`, `{"party parrot": "/p.gif"}`, `{"::": "/p.gif"}`, `{"parrot": ""}`} {
+		_, err := ParseCustomEmoji([]byte(input), "test")
+		assert.Error(suite.T(), err, "Input %q", input)
+	}
+}
+
+// TestLoadCustomEmoji tests reading a registry file
+func (suite *CustomEmojiTestSuite) TestLoadCustomEmoji() {
+	path := filepath.Join(suite.T().TempDir(), "emoji.json")
+	require.NoError(suite.T(), os.WriteFile(path, []byte(`{"parrot": "/parrot.gif"}`), 0o644))
+
+	images, err := LoadCustomEmoji(path)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{":parrot:": "/parrot.gif"}, images)
+
+	_, err = LoadCustomEmoji(filepath.Join(suite.T().TempDir(), "missing.json"))
+	assert.Error(suite.T(), err)
+}
+
+// TestCustomEmoji runs all custom emoji registry tests
+func TestCustomEmoji(t *testing.T) {
+	suite.Run(t, new(CustomEmojiTestSuite))
+}
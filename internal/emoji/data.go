@@ -4,6 +4,9 @@
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 )
 
 // EmojiMap holds the mapping from aliases to Unicode emoji characters
@@ -2573,19 +2576,69 @@
 // This is built lazily when first needed for decoding
 var ReverseEmojiMap map[string]string
 
+var (
+	reverseOnce sync.Once
+
+	// maxEmojiLength is the byte length of the longest emoji in ReverseEmojiMap
+	maxEmojiLength int
+
+	// emojiStarts holds every rune that begins an emoji in ReverseEmojiMap
+	emojiStarts map[rune]bool
+)
+
 // buildReverseMap creates the reverse mapping from emoji to alias
 func buildReverseMap() {
-	if ReverseEmojiMap != nil {
-		return
-	}
+	reverseOnce.Do(func() {
+		buildUnicodeIndex()
 
-	ReverseEmojiMap = make(map[string]string, len(EmojiMap))
-	for alias, emoji := range EmojiMap {
-		// Use the first alias found for each emoji (some emojis have multiple aliases)
-		if _, exists := ReverseEmojiMap[emoji]; !exists {
-			ReverseEmojiMap[emoji] = alias
+		reverse := make(map[string]string, len(EmojiMap)+len(unicodeAliasMap))
+		for alias, emoji := range EmojiMap {
+			// Some emoji have several aliases, so the canonical one is the shortest, then the first in sort order
+			if existing, exists := reverse[emoji]; !exists || canonicalAlias(alias, existing) {
+				reverse[emoji] = alias
+			}
 		}
+
+		// Fall back to CLDR-derived aliases for emoji the alias database doesn't name
+		for _, entry := range UnicodeEntries {
+			if _, exists := reverse[entry.Emoji]; !exists && entry.IsRGI() {
+				reverse[entry.Emoji] = entry.Alias
+			}
+		}
+
+		// Accept the unqualified and text presentation forms of every recommended emoji
+		for _, entry := range UnicodeEntries {
+			qualified, ok := FullyQualified(entry.Emoji)
+			if !ok {
+				continue
+			}
+
+			alias := reverse[qualified]
+			for _, variant := range []string{entry.Emoji, textPresentation(qualified)} {
+				if _, exists := reverse[variant]; !exists {
+					reverse[variant] = alias
+				}
+			}
+		}
+
+		emojiStarts = make(map[rune]bool)
+		for emoji := range reverse {
+			first, _ := utf8.DecodeRuneInString(emoji)
+			emojiStarts[first] = true
+			maxEmojiLength = max(maxEmojiLength, len(emoji))
+		}
+
+		ReverseEmojiMap = reverse
+	})
+}
+
+// canonicalAlias reports whether alias is preferred to other as the alias of their emoji
+func canonicalAlias(alias, other string) bool {
+	if len(alias) != len(other) {
+		return len(alias) < len(other)
 	}
+
+	return alias < other
 }
 
 // GetEmoji returns the emoji for the given alias, or the original alias if not found
@@ -2594,6 +2647,11 @@ func GetEmoji(alias string) string {
 		return emoji
 	}
 
+	buildUnicodeIndex()
+	if emoji, exists := unicodeAliasMap[alias]; exists {
+		return emoji
+	}
+
 	return alias
 }
 
@@ -2613,25 +2671,64 @@ func GetReverseMap() map[string]string {
 	return ReverseEmojiMap
 }
 
-// ListAllEmojis returns all available emojis sorted by alias
-func ListAllEmojis() []string {
-	aliases := make([]string, 0, len(EmojiMap))
+// MatchEmoji returns the longest known emoji at the start of text and its alias
+func MatchEmoji(text string) (emoji, alias string, ok bool) {
+	buildReverseMap()
+
+	first, _ := utf8.DecodeRuneInString(text)
+	if !emojiStarts[first] {
+		return "", "", false
+	}
+
+	for end := min(len(text), maxEmojiLength); end > 0; end-- {
+		if end < len(text) && !utf8.RuneStart(text[end]) {
+			continue
+		}
+
+		if alias, exists := ReverseEmojiMap[text[:end]]; exists {
+			return text[:end], alias, true
+		}
+	}
+
+	return "", "", false
+}
+
+// Aliases returns every known alias, sorted
+func Aliases() []string {
+	unicodeAliases := UnicodeAliases()
+
+	aliases := make([]string, 0, len(EmojiMap)+len(unicodeAliases))
 	for alias := range EmojiMap {
 		aliases = append(aliases, alias)
 	}
 
+	aliases = append(aliases, unicodeAliases...)
 	sort.Strings(aliases)
 
+	return aliases
+}
+
+// ListAllEmojis returns all available emojis sorted by alias
+func ListAllEmojis() []string {
+	aliases := Aliases()
+
 	result := make([]string, len(aliases))
 	for i, alias := range aliases {
-		result[i] = fmt.Sprintf("%s %s", alias, EmojiMap[alias])
+		result[i] = fmt.Sprintf("%s %s", alias, GetEmoji(alias))
 	}
 
 	return result
 }
 
 // IsValidEmojiChar checks if a character is valid for emoji aliases
+//
+// Letters and digits of every script are valid, so that localized aliases
+// such as :ロケット: can be written.
 func IsValidEmojiChar(char rune) bool {
+	if char >= utf8.RuneSelf {
+		return unicode.IsLetter(char) || unicode.IsDigit(char) || unicode.IsMark(char)
+	}
+
 	return (char >= 'a' && char <= 'z') ||
 		(char >= 'A' && char <= 'Z') ||
 		(char >= '0' && char <= '9') ||
@@ -2645,9 +2742,8 @@ func HasEmoji(text string) bool {
 
 // HasEmojiCharacters checks if the text contains Unicode emoji characters
 func HasEmojiCharacters(text string) bool {
-	buildReverseMap()
-	for emoji := range ReverseEmojiMap {
-		if strings.Contains(text, emoji) {
+	for i := range text {
+		if _, _, ok := MatchEmoji(text[i:]); ok {
 			return true
 		}
 	}
@@ -128,6 +128,10 @@ func (suite *EmojiTestSuite) TestIsValidEmojiChar() {
 		{name: "underscore", char: '_', expected: true},
 		{name: "plus", char: '+', expected: true},
 		{name: "minus", char: '-', expected: true},
+		{name: "umlaut", char: 'ä', expected: true},
+		{name: "katakana", char: 'ロ', expected: true},
+		{name: "prolonged sound mark", char: 'ー', expected: true},
+		{name: "combining mark", char: '\u0301', expected: true},
 
 		// Invalid characters
 		{name: "space", char: ' ', expected: false},
@@ -151,6 +155,9 @@ func (suite *EmojiTestSuite) TestIsValidEmojiChar() {
 		{name: "dot", char: '.', expected: false},
 		{name: "comma", char: ',', expected: false},
 		{name: "unicode emoji", char: '😀', expected: false},
+		{name: "right single quote", char: '’', expected: false},
+		{name: "ideographic full stop", char: '。', expected: false},
+		{name: "fullwidth colon", char: '：', expected: false},
 	}
 
 	for _, tt := range tests {
@@ -274,6 +281,28 @@ functionResult := GetEmoji(alias)
 	}
 }
 
+// TestCanonicalAlias tests that emoji with several aliases always decode to the same one
+func (suite *EmojiTestSuite) TestCanonicalAlias() {
+	tests := []struct {
+		emoji    string
+		expected string
+	}{
+		{"🙂", ":slight_smile:"},
+		{"👍", ":+1:"},
+		{"💩", ":poop:"},
+		{"😄", ":smile:"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(suite.T(), tt.expected, GetAlias(tt.emoji))
+	}
+
+	for alias, emoji := range EmojiMap {
+		canonical := GetAlias(emoji)
+		assert.LessOrEqual(suite.T(), len(canonical), len(alias), "%s is longer than %s", canonical, alias)
+	}
+}
+
 // TestEdgeCases tests edge cases for the emoji package
 func (suite *EmojiTestSuite) TestEdgeCases() {
 	// Test with very long alias (should return as-is)
@@ -0,0 +1,108 @@
+package emoji
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// DiffEntry describes a single alias that differs between two emoji maps
+type DiffEntry struct {
+	Alias         string `json:"alias"`
+	Old           string `json:"old,omitempty"`
+	New           string `json:"new,omitempty"`
+	VariationOnly bool   `json:"variation_only,omitempty"`
+}
+
+// DiffReport lists the aliases added, removed and changed between two emoji maps
+type DiffReport struct {
+	Added   []DiffEntry `json:"added"`
+	Removed []DiffEntry `json:"removed"`
+	Changed []DiffEntry `json:"changed"`
+}
+
+// DiffEmojiMaps compares the current alias mapping against a fresh one
+func DiffEmojiMaps(current, fresh map[string]string) *DiffReport {
+	report := &DiffReport{
+		Added:   []DiffEntry{},
+		Removed: []DiffEntry{},
+		Changed: []DiffEntry{},
+	}
+
+	for alias, oldEmoji := range current {
+		newEmoji, exists := fresh[alias]
+		switch {
+		case !exists:
+			report.Removed = append(report.Removed, DiffEntry{Alias: alias, Old: oldEmoji})
+		case newEmoji != oldEmoji:
+			report.Changed = append(report.Changed, DiffEntry{
+				Alias:         alias,
+				Old:           oldEmoji,
+				New:           newEmoji,
+				VariationOnly: StripVariationSelectors(oldEmoji) == StripVariationSelectors(newEmoji),
+			})
+		}
+	}
+
+	for alias, newEmoji := range fresh {
+		if _, exists := current[alias]; !exists {
+			report.Added = append(report.Added, DiffEntry{Alias: alias, New: newEmoji})
+		}
+	}
+
+	for _, entries := range [][]DiffEntry{report.Added, report.Removed, report.Changed} {
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Alias < entries[j].Alias
+		})
+	}
+
+	return report
+}
+
+// HasChanges reports whether the two maps differ at all
+func (r *DiffReport) HasChanges() bool {
+	return len(r.Added) > 0 || len(r.Removed) > 0 || len(r.Changed) > 0
+}
+
+// WriteText writes a human-readable version of the report
+func (r *DiffReport) WriteText(w io.Writer) error {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Added (%d):\n", len(r.Added))
+	for _, entry := range r.Added {
+		fmt.Fprintf(&builder, "  + %s %s\n", entry.Alias, entry.New)
+	}
+
+	fmt.Fprintf(&builder, "Removed (%d):\n", len(r.Removed))
+	for _, entry := range r.Removed {
+		fmt.Fprintf(&builder, "  - %s %s\n", entry.Alias, entry.Old)
+	}
+
+	fmt.Fprintf(&builder, "Changed (%d):\n", len(r.Changed))
+	for _, entry := range r.Changed {
+		// Selector-only changes look identical on screen, so show the code points
+		if entry.VariationOnly {
+			fmt.Fprintf(&builder, "  ~ %s %s → %s (variation selector only)\n",
+				entry.Alias, formatCodepoints(entry.Old), formatCodepoints(entry.New))
+		} else {
+			fmt.Fprintf(&builder, "  ~ %s %s → %s\n", entry.Alias, entry.Old, entry.New)
+		}
+	}
+
+	fmt.Fprintf(&builder, "Summary: %d added, %d removed, %d changed\n",
+		len(r.Added), len(r.Removed), len(r.Changed))
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+// formatCodepoints renders a string as space separated U+XXXX code points
+func formatCodepoints(s string) string {
+	parts := make([]string, 0, len(s))
+	for _, r := range s {
+		parts = append(parts, fmt.Sprintf("U+%04X", r))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,108 @@
+package emoji
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// DiffTestSuite defines the test suite for emoji data diffing
+type DiffTestSuite struct {
+	suite.Suite
+}
+
+// TestDiffEmojiMaps tests added, removed and changed detection
+func (suite *DiffTestSuite) TestDiffEmojiMaps() {
+	current := map[string]string{
+		":smile:":    "😄",
+		":airplane:": "✈",
+		":old:":      "👴",
+		":rocket:":   "🚀",
+		":heart:":    "❤️",
+	}
+	fresh := map[string]string{
+		":smile:":    "😄",
+		":airplane:": "✈️",
+		":new:":      "🆕",
+		":rocket:":   "🛸",
+		":heart:":    "❤️",
+	}
+
+	report := DiffEmojiMaps(current, fresh)
+
+	assert.Equal(suite.T(), []DiffEntry{{Alias: ":new:", New: "🆕"}}, report.Added)
+	assert.Equal(suite.T(), []DiffEntry{{Alias: ":old:", Old: "👴"}}, report.Removed)
+	assert.Equal(suite.T(), []DiffEntry{
+		{Alias: ":airplane:", Old: "✈", New: "✈️", VariationOnly: true},
+		{Alias: ":rocket:", Old: "🚀", New: "🛸"},
+	}, report.Changed)
+	assert.True(suite.T(), report.HasChanges())
+}
+
+// TestDiffIdenticalMaps tests that identical maps produce an empty report
+func (suite *DiffTestSuite) TestDiffIdenticalMaps() {
+	report := DiffEmojiMaps(EmojiMap, EmojiMap)
+
+	assert.False(suite.T(), report.HasChanges())
+	assert.NotNil(suite.T(), report.Added, "Empty lists should still encode as JSON arrays")
+}
+
+// TestWriteText tests the human-readable report
+func (suite *DiffTestSuite) TestWriteText() {
+	report := DiffEmojiMaps(
+		map[string]string{":airplane:": "✈", ":old:": "👴"},
+		map[string]string{":airplane:": "✈️", ":new:": "🆕"},
+	)
+
+	var buf bytes.Buffer
+	require.NoError(suite.T(), report.WriteText(&buf))
+
+	output := buf.String()
+	assert.Contains(suite.T(), output, "+ :new: 🆕")
+	assert.Contains(suite.T(), output, "- :old: 👴")
+	assert.Contains(suite.T(), output, ":airplane: U+2708 → U+2708 U+FE0F (variation selector only)")
+	assert.Contains(suite.T(), output, "Summary: 1 added, 1 removed, 1 changed")
+}
+
+// TestParseGemojiJSON tests decoding of gemoji's emoji.json format
+func (suite *DiffTestSuite) TestParseGemojiJSON() {
+	input := `[
+		{"emoji": "😄", "aliases": ["smile"], "unicode_version": "6.0"},
+		{"emoji": "👍", "aliases": ["+1", "thumbsup"], "unicode_version": "6.0"},
+		{"emoji": "🫠", "aliases": ["melting_face"], "unicode_version": "14.0"}
+	]`
+
+	result, err := ParseGemojiJSON(strings.NewReader(input))
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), 4, result.EmojiCount)
+	assert.Equal(suite.T(), map[string]string{
+		":smile:":        "😄",
+		":+1:":           "👍",
+		":thumbsup:":     "👍",
+		":melting_face:": "🫠",
+	}, result.Data)
+	assert.Equal(suite.T(), "14.0", result.Versions["🫠"])
+
+	_, err = ParseGemojiJSON(strings.NewReader("not json"))
+	assert.Error(suite.T(), err)
+}
+
+// TestGenerateVersionsGoCode tests the generated Go source
+func (suite *DiffTestSuite) TestGenerateVersionsGoCode() {
+	code := GenerateVersionsGoCode(map[string]string{"🫠": "14.0", "😄": "6.0"})
+
+	assert.True(suite.T(), strings.HasPrefix(code, "// Code generated by emojify-scraper versions; DO NOT EDIT."))
+	assert.NotContains(suite.T(), code, "//go:build", "Lite builds keep the versions")
+	assert.Contains(suite.T(), code, `"🫠": "14.0",`)
+	assert.Less(suite.T(), strings.Index(code, `"😄"`), strings.Index(code, `"🫠"`), "Emoji are sorted")
+}
+
+// TestDiff runs all diff tests
+func TestDiff(t *testing.T) {
+	suite.Run(t, new(DiffTestSuite))
+}
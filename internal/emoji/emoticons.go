@@ -0,0 +1,111 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+)
+
+// Emoticon is a classic ASCII emoticon and the alias of the emoji it stands for
+type Emoticon struct {
+	Text  string `json:"text"`
+	Alias string `json:"alias"`
+}
+
+// emoticons is the emoticon table, listing each emoticon once with the emoji it is read as
+//
+// When several emoticons are read as the same emoji, the one written for it
+// is chosen by PreferEmoticon.
+var emoticons = []Emoticon{
+	{Text: ":)", Alias: ":slightly_smiling_face:"},
+	{Text: ":-)", Alias: ":slightly_smiling_face:"},
+	{Text: ":D", Alias: ":smiley:"},
+	{Text: ":-D", Alias: ":smiley:"},
+	{Text: "XD", Alias: ":laughing:"},
+	{Text: "xD", Alias: ":laughing:"},
+	{Text: ":'D", Alias: ":joy:"},
+	{Text: ";)", Alias: ":wink:"},
+	{Text: ";-)", Alias: ":wink:"},
+	{Text: ":P", Alias: ":stuck_out_tongue:"},
+	{Text: ":-P", Alias: ":stuck_out_tongue:"},
+	{Text: ":p", Alias: ":stuck_out_tongue:"},
+	{Text: ":-p", Alias: ":stuck_out_tongue:"},
+	{Text: ";P", Alias: ":stuck_out_tongue_winking_eye:"},
+	{Text: ";-P", Alias: ":stuck_out_tongue_winking_eye:"},
+	{Text: ";p", Alias: ":stuck_out_tongue_winking_eye:"},
+	{Text: ":(", Alias: ":slightly_frowning_face:"},
+	{Text: ":-(", Alias: ":slightly_frowning_face:"},
+	{Text: ":'(", Alias: ":cry:"},
+	{Text: ":'-(", Alias: ":cry:"},
+	{Text: ":O", Alias: ":open_mouth:"},
+	{Text: ":-O", Alias: ":open_mouth:"},
+	{Text: ":o", Alias: ":open_mouth:"},
+	{Text: ":|", Alias: ":neutral_face:"},
+	{Text: ":-|", Alias: ":neutral_face:"},
+	{Text: ":/", Alias: ":confused:"},
+	{Text: ":-/", Alias: ":confused:"},
+	{Text: "8-)", Alias: ":sunglasses:"},
+	{Text: "B-)", Alias: ":sunglasses:"},
+	{Text: "O:)", Alias: ":innocent:"},
+	{Text: "O:-)", Alias: ":innocent:"},
+	{Text: ">:)", Alias: ":smiling_imp:"},
+	{Text: ">:-)", Alias: ":smiling_imp:"},
+	{Text: ">:(", Alias: ":angry:"},
+	{Text: ">:-(", Alias: ":angry:"},
+	{Text: ":*", Alias: ":kissing:"},
+	{Text: ":-*", Alias: ":kissing:"},
+	{Text: "<3", Alias: ":heart:"},
+	{Text: "</3", Alias: ":broken_heart:"},
+	{Text: "(y)", Alias: ":+1:"},
+	{Text: "(n)", Alias: ":-1:"},
+}
+
+var (
+	emoticonOnce sync.Once
+	// emoticonByEmoji indexes the emoticon written for each emoji, ignoring variation selectors
+	emoticonByEmoji map[string]string
+)
+
+// PreferEmoticon reports whether emoticon a is written in preference to b for the same emoji: the shorter one, then the first in byte order
+func PreferEmoticon(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+
+	return a < b
+}
+
+// DefaultEmoticons returns the default mapping of emoticons to the aliases of the emoji they are read as
+func DefaultEmoticons() map[string]string {
+	result := make(map[string]string, len(emoticons))
+	for _, e := range emoticons {
+		result[e.Text] = e.Alias
+	}
+
+	return result
+}
+
+// LookupEmoticon returns the ASCII emoticon for an alias or emoji, such as ":)" for 🙂
+//
+// Any alias of the emoji matches, and skin tones are ignored.
+func LookupEmoticon(query string) (string, bool) {
+	emoticonOnce.Do(func() {
+		emoticonByEmoji = make(map[string]string, len(emoticons))
+		for _, e := range emoticons {
+			key := StripVariationSelectors(GetEmoji(e.Alias))
+			if current, exists := emoticonByEmoji[key]; !exists || PreferEmoticon(e.Text, current) {
+				emoticonByEmoji[key] = e.Text
+			}
+		}
+	})
+
+	if strings.HasPrefix(query, ":") {
+		query = GetEmoji(query)
+	}
+
+	if untoned, _, ok := splitSkinTone(query); ok {
+		query = untoned
+	}
+
+	text, ok := emoticonByEmoji[StripVariationSelectors(query)]
+	return text, ok
+}
@@ -0,0 +1,77 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// EmoticonTestSuite defines the test suite for emoticon data
+type EmoticonTestSuite struct {
+	suite.Suite
+}
+
+// TestEmoticonAliasesExist tests that every emoticon alias is in the alias database, and that each emoticon is listed once
+func (suite *EmoticonTestSuite) TestEmoticonAliasesExist() {
+	seen := make(map[string]bool, len(emoticons))
+	for _, e := range emoticons {
+		assert.NotEqual(suite.T(), e.Alias, GetEmoji(e.Alias), "Alias %s should be known", e.Alias)
+		assert.NotEmpty(suite.T(), e.Text)
+		assert.False(suite.T(), seen[e.Text], "Emoticon %s is listed twice", e.Text)
+		seen[e.Text] = true
+	}
+}
+
+// TestDefaultEmoticons tests that each emoticon is read as the emoji listed for it
+func (suite *EmoticonTestSuite) TestDefaultEmoticons() {
+	defaults := DefaultEmoticons()
+	assert.Equal(suite.T(), ":slightly_smiling_face:", defaults[":)"])
+	assert.Equal(suite.T(), ":slightly_smiling_face:", defaults[":-)"])
+	assert.Equal(suite.T(), ":cry:", defaults[":'("])
+
+	defaults[":)"] = ":rocket:"
+	assert.Equal(suite.T(), ":slightly_smiling_face:", DefaultEmoticons()[":)"], "Callers should get a copy")
+}
+
+// TestLookupEmoticon tests lookups by alias and emoji
+func (suite *EmoticonTestSuite) TestLookupEmoticon() {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+		ok       bool
+	}{
+		{"emoji", "🙂", ":)", true},
+		{"alias", ":wink:", ";)", true},
+		{"other alias of the emoji", ":thumbsup:", "(y)", true},
+		{"variation selector", "❤️", "<3", true},
+		{"skin tone", "👍🏽", "(y)", true},
+		{"shortest emoticon", ":sunglasses:", "8-)", true},
+		{"shortest, then by bytes", ":stuck_out_tongue:", ":P", true},
+		{"emoji only read from other emoticons", ":blush:", "", false},
+		{"no emoticon", "🚀", "", false},
+		{"unknown alias", ":unknown:", "", false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			text, ok := LookupEmoticon(tt.query)
+			assert.Equal(suite.T(), tt.ok, ok)
+			assert.Equal(suite.T(), tt.expected, text)
+		})
+	}
+}
+
+// TestPreferEmoticon tests the order emoticons are written in
+func (suite *EmoticonTestSuite) TestPreferEmoticon() {
+	assert.True(suite.T(), PreferEmoticon(":)", ":-)"))
+	assert.True(suite.T(), PreferEmoticon("8-)", "B-)"))
+	assert.False(suite.T(), PreferEmoticon(":p", ":P"))
+	assert.False(suite.T(), PreferEmoticon(":)", ":)"))
+}
+
+// TestEmoticons runs all emoticon tests
+func TestEmoticons(t *testing.T) {
+	suite.Run(t, new(EmoticonTestSuite))
+}
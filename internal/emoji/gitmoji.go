@@ -0,0 +1,143 @@
+package emoji
+
+import (
+	"maps"
+	"strings"
+	"sync"
+)
+
+// Gitmoji describes the meaning of an emoji in the gitmoji commit convention (https://gitmoji.dev)
+type Gitmoji struct {
+	Alias       string `json:"alias"`
+	Description string `json:"description"`
+}
+
+// gitmojis is the gitmoji table, in the order published by the project
+var gitmojis = []Gitmoji{
+	{Alias: ":art:", Description: "Improve structure / format of the code"},
+	{Alias: ":zap:", Description: "Improve performance"},
+	{Alias: ":fire:", Description: "Remove code or files"},
+	{Alias: ":bug:", Description: "Fix a bug"},
+	{Alias: ":ambulance:", Description: "Critical hotfix"},
+	{Alias: ":sparkles:", Description: "Introduce new features"},
+	{Alias: ":memo:", Description: "Add or update documentation"},
+	{Alias: ":rocket:", Description: "Deploy stuff"},
+	{Alias: ":lipstick:", Description: "Add or update the UI and style files"},
+	{Alias: ":tada:", Description: "Begin a project"},
+	{Alias: ":white_check_mark:", Description: "Add, update, or pass tests"},
+	{Alias: ":lock:", Description: "Fix security or privacy issues"},
+	{Alias: ":closed_lock_with_key:", Description: "Add or update secrets"},
+	{Alias: ":bookmark:", Description: "Release / Version tags"},
+	{Alias: ":rotating_light:", Description: "Fix compiler / linter warnings"},
+	{Alias: ":construction:", Description: "Work in progress"},
+	{Alias: ":green_heart:", Description: "Fix CI Build"},
+	{Alias: ":arrow_down:", Description: "Downgrade dependencies"},
+	{Alias: ":arrow_up:", Description: "Upgrade dependencies"},
+	{Alias: ":pushpin:", Description: "Pin dependencies to specific versions"},
+	{Alias: ":construction_worker:", Description: "Add or update CI build system"},
+	{Alias: ":chart_with_upwards_trend:", Description: "Add or update analytics or track code"},
+	{Alias: ":recycle:", Description: "Refactor code"},
+	{Alias: ":heavy_plus_sign:", Description: "Add a dependency"},
+	{Alias: ":heavy_minus_sign:", Description: "Remove a dependency"},
+	{Alias: ":wrench:", Description: "Add or update configuration files"},
+	{Alias: ":hammer:", Description: "Add or update development scripts"},
+	{Alias: ":globe_with_meridians:", Description: "Internationalization and localization"},
+	{Alias: ":pencil2:", Description: "Fix typos"},
+	{Alias: ":poop:", Description: "Write bad code that needs to be improved"},
+	{Alias: ":rewind:", Description: "Revert changes"},
+	{Alias: ":twisted_rightwards_arrows:", Description: "Merge branches"},
+	{Alias: ":package:", Description: "Add or update compiled files or packages"},
+	{Alias: ":alien:", Description: "Update code due to external API changes"},
+	{Alias: ":truck:", Description: "Move or rename resources (e.g.: files, paths, routes)"},
+	{Alias: ":page_facing_up:", Description: "Add or update license"},
+	{Alias: ":boom:", Description: "Introduce breaking changes"},
+	{Alias: ":bento:", Description: "Add or update assets"},
+	{Alias: ":wheelchair:", Description: "Improve accessibility"},
+	{Alias: ":bulb:", Description: "Add or update comments in source code"},
+	{Alias: ":beers:", Description: "Write code drunkenly"},
+	{Alias: ":speech_balloon:", Description: "Add or update text and literals"},
+	{Alias: ":card_file_box:", Description: "Perform database related changes"},
+	{Alias: ":loud_sound:", Description: "Add or update logs"},
+	{Alias: ":mute:", Description: "Remove logs"},
+	{Alias: ":busts_in_silhouette:", Description: "Add or update contributor(s)"},
+	{Alias: ":children_crossing:", Description: "Improve user experience / usability"},
+	{Alias: ":building_construction:", Description: "Make architectural changes"},
+	{Alias: ":iphone:", Description: "Work on responsive design"},
+	{Alias: ":clown_face:", Description: "Mock things"},
+	{Alias: ":egg:", Description: "Add or update an easter egg"},
+	{Alias: ":see_no_evil:", Description: "Add or update a .gitignore file"},
+	{Alias: ":camera_flash:", Description: "Add or update snapshots"},
+	{Alias: ":alembic:", Description: "Perform experiments"},
+	{Alias: ":mag:", Description: "Improve SEO"},
+	{Alias: ":label:", Description: "Add or update types"},
+	{Alias: ":seedling:", Description: "Add or update seed files"},
+	{Alias: ":triangular_flag_on_post:", Description: "Add, update, or remove feature flags"},
+	{Alias: ":goal_net:", Description: "Catch errors"},
+	{Alias: ":dizzy:", Description: "Add or update animations and transitions"},
+	{Alias: ":wastebasket:", Description: "Deprecate code that needs to be cleaned up"},
+	{Alias: ":passport_control:", Description: "Work on code related to authorization, roles and permissions"},
+	{Alias: ":adhesive_bandage:", Description: "Simple fix for a non-critical issue"},
+	{Alias: ":monocle_face:", Description: "Data exploration/inspection"},
+	{Alias: ":coffin:", Description: "Remove dead code"},
+	{Alias: ":test_tube:", Description: "Add a failing test"},
+	{Alias: ":necktie:", Description: "Add or update business logic"},
+	{Alias: ":stethoscope:", Description: "Add or update healthcheck"},
+	{Alias: ":bricks:", Description: "Infrastructure related changes"},
+	{Alias: ":technologist:", Description: "Improve developer experience"},
+	{Alias: ":money_with_wings:", Description: "Add sponsorships or money related infrastructure"},
+	{Alias: ":thread:", Description: "Add or update code related to multithreading or concurrency"},
+	{Alias: ":safety_vest:", Description: "Add or update code related to validation"},
+	{Alias: ":airplane:", Description: "Improve offline support"},
+}
+
+// defaultCommitTypes maps conventional commit types to the gitmoji that describes them
+var defaultCommitTypes = map[string]string{
+	"feat":     ":sparkles:",
+	"fix":      ":bug:",
+	"docs":     ":memo:",
+	"style":    ":art:",
+	"refactor": ":recycle:",
+	"perf":     ":zap:",
+	"test":     ":white_check_mark:",
+	"build":    ":package:",
+	"ci":       ":construction_worker:",
+	"chore":    ":wrench:",
+	"revert":   ":rewind:",
+}
+
+var (
+	gitmojiOnce sync.Once
+	// gitmojiByEmoji indexes gitmojis by emoji, ignoring variation selectors
+	gitmojiByEmoji map[string]Gitmoji
+)
+
+// Gitmojis returns the gitmoji table
+func Gitmojis() []Gitmoji {
+	result := make([]Gitmoji, len(gitmojis))
+	copy(result, gitmojis)
+	return result
+}
+
+// LookupGitmoji returns the gitmoji meaning of an alias or emoji
+//
+// Any alias of a gitmoji emoji matches, so :pencil: finds the :memo: entry.
+func LookupGitmoji(query string) (Gitmoji, bool) {
+	gitmojiOnce.Do(func() {
+		gitmojiByEmoji = make(map[string]Gitmoji, len(gitmojis))
+		for _, g := range gitmojis {
+			gitmojiByEmoji[StripVariationSelectors(GetEmoji(g.Alias))] = g
+		}
+	})
+
+	if strings.HasPrefix(query, ":") {
+		query = GetEmoji(query)
+	}
+
+	g, ok := gitmojiByEmoji[StripVariationSelectors(query)]
+	return g, ok
+}
+
+// DefaultCommitTypes returns the default mapping of conventional commit types to gitmoji aliases
+func DefaultCommitTypes() map[string]string {
+	return maps.Clone(defaultCommitTypes)
+}
@@ -0,0 +1,63 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// GitmojiTestSuite defines the test suite for gitmoji data
+type GitmojiTestSuite struct {
+	suite.Suite
+}
+
+// TestGitmojiAliasesExist tests that every gitmoji alias is in the alias database
+func (suite *GitmojiTestSuite) TestGitmojiAliasesExist() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	seen := make(map[string]bool)
+	for _, g := range Gitmojis() {
+		assert.NotEqual(suite.T(), g.Alias, GetEmoji(g.Alias), "Alias %s should be known", g.Alias)
+		assert.NotEmpty(suite.T(), g.Description)
+		assert.False(suite.T(), seen[g.Alias], "Alias %s is listed twice", g.Alias)
+		seen[g.Alias] = true
+	}
+
+	for commitType, alias := range DefaultCommitTypes() {
+		_, ok := LookupGitmoji(alias)
+		assert.True(suite.T(), ok, "Type %s should map to a gitmoji", commitType)
+	}
+}
+
+// TestLookupGitmoji tests lookups by alias and emoji
+func (suite *GitmojiTestSuite) TestLookupGitmoji() {
+	g, ok := LookupGitmoji("🐛")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), Gitmoji{Alias: ":bug:", Description: "Fix a bug"}, g)
+
+	g, ok = LookupGitmoji(":pencil:")
+	require.True(suite.T(), ok, "Other aliases of a gitmoji emoji should match")
+	assert.Equal(suite.T(), ":memo:", g.Alias)
+
+	g, ok = LookupGitmoji("⚡")
+	require.True(suite.T(), ok, "Variation selectors should be ignored")
+	assert.Equal(suite.T(), ":zap:", g.Alias)
+
+	for _, query := range []string{"🐈", ":cat:", ":unknown:", ""} {
+		_, ok := LookupGitmoji(query)
+		assert.False(suite.T(), ok, "Query %q", query)
+	}
+
+	types := DefaultCommitTypes()
+	types["feat"] = ":rocket:"
+	assert.Equal(suite.T(), ":sparkles:", DefaultCommitTypes()["feat"], "Callers should get a copy")
+}
+
+// TestGitmoji runs all gitmoji tests
+func TestGitmoji(t *testing.T) {
+	suite.Run(t, new(GitmojiTestSuite))
+}
@@ -0,0 +1,67 @@
+package emoji
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ImageNaming selects the file naming scheme of an emoji image set
+type ImageNaming int
+
+const (
+	// NamingTwemoji names images by lowercase codepoints joined with "-", such as
+	// "1f44b-1f3fd", dropping VS16 unless the emoji is a ZWJ sequence
+	NamingTwemoji ImageNaming = iota
+	// NamingNoto names images like Noto Emoji, such as "emoji_u1f44b_1f3fd",
+	// with codepoints padded to four digits and every VS16 dropped
+	NamingNoto
+)
+
+// String returns the name used for the naming scheme on the command line
+func (n ImageNaming) String() string {
+	if n == NamingNoto {
+		return "noto"
+	}
+
+	return "twemoji"
+}
+
+// ParseImageNaming converts a naming scheme name into an ImageNaming
+func ParseImageNaming(name string) (ImageNaming, error) {
+	switch strings.ToLower(name) {
+	case "", "twemoji":
+		return NamingTwemoji, nil
+	case "noto":
+		return NamingNoto, nil
+	default:
+		return NamingTwemoji, fmt.Errorf("unknown image naming %q (expected twemoji or noto)", name)
+	}
+}
+
+// ImageName returns the file name, without extension, of the image for an emoji
+//
+// Text presentation selectors are always dropped, since image sets only
+// contain emoji presentation.
+func ImageName(emoji string, naming ImageNaming) string {
+	keepVS16 := naming == NamingTwemoji && strings.ContainsRune(emoji, ZeroWidthJoiner)
+
+	var codepoints []string
+	for _, r := range emoji {
+		if r == TextVariationSelector || (r == EmojiVariationSelector && !keepVS16) {
+			continue
+		}
+
+		if naming == NamingNoto {
+			codepoints = append(codepoints, fmt.Sprintf("%04x", r))
+		} else {
+			codepoints = append(codepoints, strconv.FormatInt(int64(r), 16))
+		}
+	}
+
+	if naming == NamingNoto {
+		return "emoji_u" + strings.Join(codepoints, "_")
+	}
+
+	return strings.Join(codepoints, "-")
+}
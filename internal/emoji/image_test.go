@@ -0,0 +1,58 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// ImageTestSuite defines the test suite for emoji image naming
+type ImageTestSuite struct {
+	suite.Suite
+}
+
+// TestParseImageNaming tests parsing of naming scheme names
+func (suite *ImageTestSuite) TestParseImageNaming() {
+	for name, expected := range map[string]ImageNaming{"": NamingTwemoji, "twemoji": NamingTwemoji, "Noto": NamingNoto} {
+		naming, err := ParseImageNaming(name)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, naming, "Name %q", name)
+	}
+
+	_, err := ParseImageNaming("openmoji")
+	assert.Error(suite.T(), err)
+}
+
+// TestImageName tests codepoint-based file names for each naming scheme
+func (suite *ImageTestSuite) TestImageName() {
+	tests := []struct {
+		name    string
+		emoji   string
+		twemoji string
+		noto    string
+	}{
+		{name: "single codepoint", emoji: "😄", twemoji: "1f604", noto: "emoji_u1f604"},
+		{name: "short codepoint", emoji: "©️", twemoji: "a9", noto: "emoji_u00a9"},
+		{name: "emoji presentation", emoji: "❤️", twemoji: "2764", noto: "emoji_u2764"},
+		{name: "text presentation", emoji: "✈︎", twemoji: "2708", noto: "emoji_u2708"},
+		{name: "skin tone", emoji: "👋🏽", twemoji: "1f44b-1f3fd", noto: "emoji_u1f44b_1f3fd"},
+		{name: "keycap", emoji: "#️⃣", twemoji: "23-20e3", noto: "emoji_u0023_20e3"},
+		{name: "ZWJ sequence", emoji: "👩‍💻", twemoji: "1f469-200d-1f4bb", noto: "emoji_u1f469_200d_1f4bb"},
+		{name: "ZWJ sequence with VS16", emoji: "🏳️‍🌈", twemoji: "1f3f3-fe0f-200d-1f308", noto: "emoji_u1f3f3_200d_1f308"},
+		{name: "flag", emoji: "🇳🇿", twemoji: "1f1f3-1f1ff", noto: "emoji_u1f1f3_1f1ff"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.twemoji, ImageName(tt.emoji, NamingTwemoji))
+			assert.Equal(suite.T(), tt.noto, ImageName(tt.emoji, NamingNoto))
+		})
+	}
+}
+
+// TestImage runs all image naming tests
+func TestImage(t *testing.T) {
+	suite.Run(t, new(ImageTestSuite))
+}
@@ -0,0 +1,431 @@
+package emoji
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// cldrInherited is the value CLDR uses for annotations inherited from the parent locale
+const cldrInherited = "↑↑↑"
+
+// LocaleEntry is the CLDR annotation of an emoji in one language
+type LocaleEntry struct {
+	Emoji string
+	// Alias is derived from the localized name, such as ":rakete:" for 🚀 in German
+	Alias    string
+	Name     string
+	Keywords []string
+}
+
+// LoadCLDRAnnotationFile parses a local copy of a CLDR annotation file such as common/annotations/de.xml
+func LoadCLDRAnnotationFile(path string) (string, []LocaleEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open CLDR annotations: %w", err)
+	}
+
+	defer file.Close()
+
+	return ParseCLDRAnnotations(file)
+}
+
+// cldrVersionPattern matches the CLDR release declared in common/dtd/ldml.dtd
+var cldrVersionPattern = regexp.MustCompile(`cldrVersion\s+CDATA\s+#FIXED\s+"([^"]+)"`)
+
+// CLDRVersion returns the CLDR release of a local copy of CLDR's common directory
+func CLDRVersion(commonDir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(commonDir, "dtd", "ldml.dtd"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the CLDR version: %w", err)
+	}
+
+	match := cldrVersionPattern.FindSubmatch(data)
+	if match == nil {
+		return "", fmt.Errorf("no cldrVersion declared in %s", filepath.Join(commonDir, "dtd", "ldml.dtd"))
+	}
+
+	return string(match[1]), nil
+}
+
+// LoadCLDRLocale reads the annotations of a locale from a local copy of CLDR's common directory
+//
+// Names and keywords come from annotations/<code>.xml, extended with the
+// sequences in annotationsDerived/<code>.xml, such as skin tones and flags,
+// when that file exists. Every entry is given a localized alias.
+func LoadCLDRLocale(commonDir, code string) ([]LocaleEntry, error) {
+	_, entries, err := LoadCLDRAnnotationFile(filepath.Join(commonDir, "annotations", code+".xml"))
+	if err != nil {
+		return nil, err
+	}
+
+	_, derived, err := LoadCLDRAnnotationFile(filepath.Join(commonDir, "annotationsDerived", code+".xml"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		seen[entry.Emoji] = true
+	}
+
+	for _, entry := range derived {
+		if !seen[entry.Emoji] {
+			entries = append(entries, entry)
+			seen[entry.Emoji] = true
+		}
+	}
+
+	AssignLocaleAliases(entries)
+
+	return entries, nil
+}
+
+// ParseCLDRAnnotations parses data in the format of CLDR's annotation files, returning the locale code and its entries
+//
+// Each emoji has a keyword annotation and a name (type="tts") annotation:
+//
+//	<annotation cp="🚀">Rakete | Raumfahrt | Weltraum</annotation>
+//	<annotation cp="🚀" type="tts">Rakete</annotation>
+//
+// Annotations of symbols that aren't emoji, and emoji without a name, are
+// skipped. Entries are returned in file order without aliases; see
+// AssignLocaleAliases.
+func ParseCLDRAnnotations(r io.Reader) (string, []LocaleEntry, error) {
+	var document struct {
+		Identity struct {
+			Language struct {
+				Type string `xml:"type,attr"`
+			} `xml:"language"`
+			Script struct {
+				Type string `xml:"type,attr"`
+			} `xml:"script"`
+			Territory struct {
+				Type string `xml:"type,attr"`
+			} `xml:"territory"`
+		} `xml:"identity"`
+		Annotations []struct {
+			CP    string `xml:"cp,attr"`
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"annotations>annotation"`
+	}
+
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return "", nil, fmt.Errorf("invalid CLDR annotations: %w", err)
+	}
+
+	locale := document.Identity.Language.Type
+	for _, part := range []string{document.Identity.Script.Type, document.Identity.Territory.Type} {
+		if part != "" {
+			locale += "_" + part
+		}
+	}
+
+	if locale == "" {
+		return "", nil, fmt.Errorf("invalid CLDR annotations: no language in identity")
+	}
+
+	var entries []LocaleEntry
+	byEmoji := make(map[string]int)
+
+	for _, annotation := range document.Annotations {
+		value := strings.TrimSpace(annotation.Value)
+		if value == "" || value == cldrInherited {
+			continue
+		}
+
+		emoji, ok := qualifiedEmoji(annotation.CP)
+		if !ok {
+			continue
+		}
+
+		i, exists := byEmoji[emoji]
+		if !exists {
+			i = len(entries)
+			byEmoji[emoji] = i
+			entries = append(entries, LocaleEntry{Emoji: emoji})
+		}
+
+		if annotation.Type == "tts" {
+			entries[i].Name = value
+			continue
+		}
+
+		for keyword := range strings.SplitSeq(value, "|") {
+			if keyword = strings.TrimSpace(keyword); keyword != "" {
+				entries[i].Keywords = append(entries[i].Keywords, keyword)
+			}
+		}
+	}
+
+	named := entries[:0]
+	for _, entry := range entries {
+		if entry.Name != "" {
+			named = append(named, entry)
+		}
+	}
+
+	return locale, named, nil
+}
+
+// qualifiedEmoji returns the recommended form of an emoji, as CLDR writes emoji without variation selectors
+func qualifiedEmoji(cp string) (string, bool) {
+	buildUnicodeIndex()
+
+	if i, exists := unicodeByBase[StripVariationSelectors(cp)]; exists {
+		return UnicodeEntries[i].Emoji, true
+	}
+
+	return "", false
+}
+
+// LocaleAlias derives an alias from a localized emoji name
+//
+// Letters are lowercased and kept in any script, and other characters separate
+// words. For example "Rakete" becomes ":rakete:", "rotes Herz" becomes
+// ":rotes_herz:" and "ロケット" stays ":ロケット:".
+func LocaleAlias(name string) string {
+	var builder strings.Builder
+	builder.WriteRune(':')
+
+	pendingSeparator := false
+	for _, char := range strings.ToLower(name) {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) || unicode.IsMark(char) {
+			if pendingSeparator && builder.Len() > 1 {
+				builder.WriteRune('_')
+			}
+
+			builder.WriteRune(char)
+			pendingSeparator = false
+		} else {
+			pendingSeparator = true
+		}
+	}
+
+	builder.WriteRune(':')
+
+	return builder.String()
+}
+
+// AssignLocaleAliases gives every entry a unique alias derived from its name
+//
+// Aliases that are taken by another entry, or that already name a different
+// emoji in the standard dictionary, are disambiguated with a numeric suffix.
+func AssignLocaleAliases(entries []LocaleEntry) {
+	used := make(map[string]string, len(entries))
+
+	for i := range entries {
+		entry := &entries[i]
+
+		base := LocaleAlias(entry.Name)
+		alias := base
+
+		for suffix := 2; localeAliasTaken(alias, entry.Emoji, used); suffix++ {
+			alias = fmt.Sprintf("%s_%d:", strings.TrimSuffix(base, ":"), suffix)
+		}
+
+		entry.Alias = alias
+		used[alias] = entry.Emoji
+	}
+}
+
+// localeAliasTaken reports whether alias already names an emoji other than the given one
+func localeAliasTaken(alias, emoji string, used map[string]string) bool {
+	if _, exists := used[alias]; exists || alias == "::" {
+		return true
+	}
+
+	existing := GetEmoji(alias)
+	return existing != alias && StripVariationSelectors(existing) != StripVariationSelectors(emoji)
+}
+
+// GenerateLocaleGoCode generates Go code for the CLDR annotations of several locales
+//
+// The header records version, the CLDR release the annotations come from.
+func GenerateLocaleGoCode(locales map[string][]LocaleEntry, version string) string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("// Code generated by emojify-scraper cldr from CLDR %s; DO NOT EDIT.\n\n", version))
+	builder.WriteString("//go:build !emojify_lite\n\n")
+	builder.WriteString("package emoji\n\n")
+	builder.WriteString("// LocaleEntries holds the CLDR annotations of each generated locale, keyed by locale code\n")
+	builder.WriteString("var LocaleEntries = map[string][]LocaleEntry{\n")
+
+	codes := make([]string, 0, len(locales))
+	for code := range locales {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		builder.WriteString(fmt.Sprintf("\t%q: {\n", code))
+
+		for _, entry := range locales[code] {
+			keywords := make([]string, len(entry.Keywords))
+			for i, keyword := range entry.Keywords {
+				keywords[i] = fmt.Sprintf("%q", keyword)
+			}
+
+			builder.WriteString(fmt.Sprintf(
+				"\t\t{Emoji: %q, Alias: %q, Name: %q, Keywords: []string{%s}},\n",
+				entry.Emoji, entry.Alias, entry.Name, strings.Join(keywords, ", "),
+			))
+		}
+
+		builder.WriteString("\t},\n")
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+// Locale is the emoji dictionary of one language
+type Locale struct {
+	Code    string
+	Entries []LocaleEntry
+
+	// byAlias indexes Entries by their alias
+	byAlias map[string]int
+
+	// byEmoji indexes Entries by their emoji without variation selectors
+	byEmoji map[string]int
+}
+
+// NewLocale creates the dictionary of a locale from its entries
+func NewLocale(code string, entries []LocaleEntry) *Locale {
+	locale := &Locale{
+		Code:    code,
+		Entries: entries,
+		byAlias: make(map[string]int, len(entries)),
+		byEmoji: make(map[string]int, len(entries)),
+	}
+
+	for i, entry := range entries {
+		locale.byAlias[entry.Alias] = i
+		locale.byEmoji[StripVariationSelectors(entry.Emoji)] = i
+	}
+
+	return locale
+}
+
+var (
+	localesOnce sync.Once
+
+	// locales holds the dictionaries of LocaleEntries, keyed by lowercase locale code
+	locales map[string]*Locale
+)
+
+// FindLocale returns the dictionary for a locale name such as "de", "de-AT" or "de_DE.UTF-8"
+//
+// A territory or script without its own dictionary falls back to the
+// language, so "de_DE" finds "de".
+func FindLocale(name string) (*Locale, bool) {
+	localesOnce.Do(func() {
+		locales = make(map[string]*Locale, len(LocaleEntries))
+		for code, entries := range LocaleEntries {
+			locales[strings.ToLower(code)] = NewLocale(code, entries)
+		}
+	})
+
+	return matchLocale(locales, name)
+}
+
+// matchLocale finds the dictionary for a locale name in dictionaries keyed by lowercase locale code
+func matchLocale(dictionaries map[string]*Locale, name string) (*Locale, bool) {
+	name, _, _ = strings.Cut(name, ".")
+	name, _, _ = strings.Cut(name, "@")
+	name = strings.ToLower(strings.ReplaceAll(name, "-", "_"))
+
+	for name != "" {
+		if locale, exists := dictionaries[name]; exists {
+			return locale, true
+		}
+
+		cut := strings.LastIndex(name, "_")
+		if cut < 0 {
+			break
+		}
+
+		name = name[:cut]
+	}
+
+	return nil, false
+}
+
+// Locales returns the codes of the generated locale dictionaries, sorted
+func Locales() []string {
+	codes := make([]string, 0, len(LocaleEntries))
+	for code := range LocaleEntries {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	return codes
+}
+
+// GetEmoji returns the emoji for a localized alias
+func (l *Locale) GetEmoji(alias string) (string, bool) {
+	if i, exists := l.byAlias[alias]; exists {
+		return l.Entries[i].Emoji, true
+	}
+
+	return "", false
+}
+
+// Entry returns the localized annotation of an emoji, ignoring variation selectors
+func (l *Locale) Entry(emoji string) (LocaleEntry, bool) {
+	if i, exists := l.byEmoji[StripVariationSelectors(emoji)]; exists {
+		return l.Entries[i], true
+	}
+
+	return LocaleEntry{}, false
+}
+
+// Lookup returns information about a localized or standard alias, or an emoji, with its localized name
+func (l *Locale) Lookup(query string) (Info, bool) {
+	alias := normalizeAlias(strings.TrimSpace(query))
+	if emoji, ok := l.GetEmoji(alias); ok {
+		return l.localize(newInfo(emoji, alias)), true
+	}
+
+	info, ok := Lookup(query)
+	if !ok {
+		return Info{}, false
+	}
+
+	return l.localize(info), true
+}
+
+// Search finds emoji like the package-level Search, also matching localized aliases, names and keywords
+func (l *Locale) Search(query string, limit int) []Info {
+	results := search(query, limit, l)
+	for i := range results {
+		results[i] = l.localize(results[i])
+	}
+
+	return results
+}
+
+// localize adds the localized alias, name and keywords of the emoji to info
+func (l *Locale) localize(info Info) Info {
+	if entry, ok := l.Entry(info.Emoji); ok {
+		info.LocalAlias = entry.Alias
+		info.LocalName = entry.Name
+		info.Keywords = entry.Keywords
+	}
+
+	return info
+}
@@ -0,0 +1,9 @@
+//go:build emojify_lite
+
+package emoji
+
+// Lite builds leave out the CLDR annotation data, so no localized aliases or
+// names are available.
+
+// LocaleEntries is empty in lite builds
+var LocaleEntries map[string][]LocaleEntry
@@ -0,0 +1,259 @@
+package emoji
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// LocaleTestSuite defines the test suite for CLDR annotation ingestion and localized dictionaries
+type LocaleTestSuite struct {
+	suite.Suite
+	german *Locale
+}
+
+// SetupTest loads the German sample annotations for each test
+func (suite *LocaleTestSuite) SetupTest() {
+	entries, err := LoadCLDRLocale(filepath.Join("testdata", "cldr"), "de")
+	require.NoError(suite.T(), err)
+
+	suite.german = NewLocale("de", entries)
+}
+
+// TestParseCLDRAnnotations tests parsing of the CLDR annotation format
+func (suite *LocaleTestSuite) TestParseCLDRAnnotations() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	locale, entries, err := LoadCLDRAnnotationFile(filepath.Join("testdata", "cldr", "annotations", "ja.xml"))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ja", locale)
+	require.Len(suite.T(), entries, 3)
+
+	assert.Equal(suite.T(), LocaleEntry{Emoji: "🚀", Name: "ロケット", Keywords: []string{"ロケット", "宇宙"}}, entries[0])
+	assert.Equal(suite.T(), "❤️", entries[2].Emoji, "Emoji are qualified with VS16")
+
+	_, _, err = ParseCLDRAnnotations(strings.NewReader("<ldml><annotations/></ldml>"))
+	assert.Error(suite.T(), err, "The identity names the locale")
+
+	_, _, err = ParseCLDRAnnotations(strings.NewReader("not xml"))
+	assert.Error(suite.T(), err)
+
+	locale, _, err = ParseCLDRAnnotations(strings.NewReader(`<ldml><identity><language type="zh"/><script type="Hant"/><territory type="HK"/></identity></ldml>`))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "zh_Hant_HK", locale)
+}
+
+// TestLoadCLDRLocale tests merging annotations with derived annotations and assigning aliases
+func (suite *LocaleTestSuite) TestLoadCLDRLocale() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name  string
+		emoji string
+		alias string
+	}{
+		{"simple name", "🚀", ":rakete:"},
+		{"words", "❤️", ":rotes_herz:"},
+		{"same alias as the standard one", "🍕", ":pizza:"},
+		{"derived skin tone", "👍🏽", ":daumen_hoch_mittlere_hautfarbe:"},
+		{"derived flag", "🇩🇪", ":flagge_deutschland:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			entry, ok := suite.german.Entry(tt.emoji)
+			require.True(suite.T(), ok)
+			assert.Equal(suite.T(), tt.alias, entry.Alias)
+		})
+	}
+
+	_, ok := suite.german.Entry("←")
+	assert.False(suite.T(), ok, "Symbols that aren't emoji are skipped")
+
+	_, ok = suite.german.Entry("😂")
+	assert.False(suite.T(), ok, "Inherited names are skipped")
+
+	_, err := LoadCLDRLocale(filepath.Join("testdata", "cldr"), "fr")
+	assert.Error(suite.T(), err)
+
+	entries, err := LoadCLDRLocale(filepath.Join("testdata", "cldr"), "ja")
+	require.NoError(suite.T(), err, "Derived annotations are optional")
+	assert.Equal(suite.T(), ":ロケット:", entries[0].Alias)
+}
+
+// TestLocaleAlias tests deriving aliases from localized names
+func (suite *LocaleTestSuite) TestLocaleAlias() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"single word", "Rakete", ":rakete:"},
+		{"several words", "rotes Herz", ":rotes_herz:"},
+		{"umlauts are kept", "Gesicht mit Freudentränen", ":gesicht_mit_freudentränen:"},
+		{"punctuation", "Flagge: Deutschland", ":flagge_deutschland:"},
+		{"japanese", "ロケット", ":ロケット:"},
+		{"mixed scripts", "OK ボタン", ":ok_ボタン:"},
+		{"combining marks", "café", ":café:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			alias := LocaleAlias(tt.input)
+			assert.Equal(suite.T(), tt.expected, alias)
+
+			for _, char := range strings.Trim(alias, ":") {
+				assert.True(suite.T(), IsValidEmojiChar(char), "Invalid char in alias %s: %c", alias, char)
+			}
+		})
+	}
+}
+
+// TestAssignLocaleAliases tests that localized aliases are unique
+func (suite *LocaleTestSuite) TestAssignLocaleAliases() {
+	entries := []LocaleEntry{
+		{Emoji: "🚀", Name: "Rakete"},
+		{Emoji: "🐛", Name: "Rakete"},
+		{Emoji: "🐛", Name: "Rocket"},
+		{Emoji: "🚀", Name: "Rocket"},
+	}
+
+	AssignLocaleAliases(entries)
+
+	assert.Equal(suite.T(), ":rakete:", entries[0].Alias)
+	assert.Equal(suite.T(), ":rakete_2:", entries[1].Alias)
+	assert.Equal(suite.T(), ":rocket_2:", entries[2].Alias, "Standard aliases of other emoji are taken")
+	assert.Equal(suite.T(), ":rocket:", entries[3].Alias, "Standard aliases of the same emoji are not")
+}
+
+// TestGenerateLocaleGoCode tests the generated Go source
+func (suite *LocaleTestSuite) TestGenerateLocaleGoCode() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	code := GenerateLocaleGoCode(map[string][]LocaleEntry{
+		"ja": {{Emoji: "🚀", Alias: ":ロケット:", Name: "ロケット", Keywords: []string{"宇宙"}}},
+		"de": suite.german.Entries[:1],
+	}, "46")
+
+	assert.True(suite.T(), strings.HasPrefix(code, "// Code generated by emojify-scraper cldr from CLDR 46; DO NOT EDIT."))
+	assert.Contains(suite.T(), code, "//go:build !emojify_lite")
+	assert.Contains(suite.T(), code, `{Emoji: "🚀", Alias: ":ロケット:", Name: "ロケット", Keywords: []string{"宇宙"}},`)
+	assert.Less(suite.T(), strings.Index(code, `"de": {`), strings.Index(code, `"ja": {`), "Locales are sorted")
+}
+
+// TestCLDRVersion tests reading the CLDR release of a common directory
+func (suite *LocaleTestSuite) TestCLDRVersion() {
+	version, err := CLDRVersion(filepath.Join("testdata", "cldr"))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "46", version)
+
+	_, err = CLDRVersion(suite.T().TempDir())
+	assert.Error(suite.T(), err)
+}
+
+// TestMatchLocale tests finding dictionaries by locale name
+func (suite *LocaleTestSuite) TestMatchLocale() {
+	dictionaries := map[string]*Locale{
+		"de":    suite.german,
+		"de_ch": NewLocale("de_CH", nil),
+		"ja":    NewLocale("ja", nil),
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"language", "de", "de"},
+		{"environment", "de_DE.UTF-8", "de"},
+		{"territory", "de_CH", "de_CH"},
+		{"hyphen", "de-CH", "de_CH"},
+		{"modifier", "de_AT@euro", "de"},
+		{"case", "JA_jp", "ja"},
+		{"unknown", "fr_FR", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			locale, ok := matchLocale(dictionaries, tt.input)
+			assert.Equal(suite.T(), tt.expected != "", ok)
+			if ok {
+				assert.Equal(suite.T(), tt.expected, locale.Code)
+			}
+		})
+	}
+}
+
+// TestLookup tests looking up localized and standard aliases with localized details
+func (suite *LocaleTestSuite) TestLookup() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	emoji, ok := suite.german.GetEmoji(":rakete:")
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "🚀", emoji)
+
+	_, ok = suite.german.GetEmoji(":rocket:")
+	assert.False(suite.T(), ok, "Standard aliases aren't part of the locale")
+
+	for _, query := range []string{"rakete", ":rakete:", ":rocket:", "🚀"} {
+		info, ok := suite.german.Lookup(query)
+		require.True(suite.T(), ok, "Query %q", query)
+		assert.Equal(suite.T(), "🚀", info.Emoji)
+		assert.Equal(suite.T(), ":rakete:", info.LocalAlias)
+		assert.Equal(suite.T(), "Rakete", info.LocalName)
+		assert.Equal(suite.T(), []string{"Rakete", "Raumfahrt", "Weltraum"}, info.Keywords)
+		assert.Contains(suite.T(), info.Aliases, ":rocket:")
+	}
+
+	info, ok := suite.german.Lookup(":rakete:")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), ":rakete:", info.Alias)
+
+	info, ok = suite.german.Lookup(":sparkles:")
+	require.True(suite.T(), ok, "Emoji without a localized name are still found")
+	assert.Empty(suite.T(), info.LocalName)
+
+	_, ok = suite.german.Lookup(":unbekannt:")
+	assert.False(suite.T(), ok)
+}
+
+// TestSearch tests searching localized names and keywords
+func (suite *LocaleTestSuite) TestSearch() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	results := suite.german.Search("raumfahrt", 0)
+	require.NotEmpty(suite.T(), results)
+	assert.Equal(suite.T(), "🚀", results[0].Emoji)
+	assert.Equal(suite.T(), "Rakete", results[0].LocalName)
+
+	results = suite.german.Search("rotes herz", 1)
+	require.Len(suite.T(), results, 1)
+	assert.Equal(suite.T(), ":rotes_herz:", results[0].Alias)
+
+	results = suite.german.Search("rocket", 1)
+	require.Len(suite.T(), results, 1)
+	assert.Equal(suite.T(), ":rocket:", results[0].Alias, "Standard aliases still match")
+	assert.Equal(suite.T(), ":rakete:", results[0].LocalAlias)
+
+	assert.Empty(suite.T(), Search("raumfahrt", 0), "Localized keywords need a locale")
+}
+
+// TestLocale runs all locale tests
+func TestLocale(t *testing.T) {
+	suite.Run(t, new(LocaleTestSuite))
+}
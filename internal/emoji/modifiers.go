@@ -0,0 +1,345 @@
+package emoji
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// SkinTone selects one of the five Fitzpatrick skin tone modifiers
+type SkinTone int
+
+const (
+	// SkinToneNone leaves emoji in their default (yellow) form
+	SkinToneNone SkinTone = iota
+	// SkinToneLight is U+1F3FB, used by the _tone1 aliases
+	SkinToneLight
+	// SkinToneMediumLight is U+1F3FC, used by the _tone2 aliases
+	SkinToneMediumLight
+	// SkinToneMedium is U+1F3FD, used by the _tone3 aliases
+	SkinToneMedium
+	// SkinToneMediumDark is U+1F3FE, used by the _tone4 aliases
+	SkinToneMediumDark
+	// SkinToneDark is U+1F3FF, used by the _tone5 aliases
+	SkinToneDark
+)
+
+// firstSkinToneModifier is the modifier for SkinToneLight; the others follow it
+const firstSkinToneModifier = '\U0001F3FB'
+
+var skinToneNames = []string{"none", "light", "medium-light", "medium", "medium-dark", "dark"}
+
+// String returns the name of the skin tone
+func (t SkinTone) String() string {
+	if t < SkinToneNone || t > SkinToneDark {
+		return fmt.Sprintf("SkinTone(%d)", int(t))
+	}
+
+	return skinToneNames[t]
+}
+
+// Modifier returns the skin tone modifier character, or 0 for SkinToneNone
+func (t SkinTone) Modifier() rune {
+	if t <= SkinToneNone || t > SkinToneDark {
+		return 0
+	}
+
+	return firstSkinToneModifier + rune(t-SkinToneLight)
+}
+
+// ParseSkinTone converts a tone number (1-5) or name (e.g. "medium-dark") into a SkinTone
+func ParseSkinTone(value string) (SkinTone, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	switch value {
+	case "", "0":
+		return SkinToneNone, nil
+	case "1", "2", "3", "4", "5":
+		return SkinTone(value[0] - '0'), nil
+	}
+
+	for i, name := range skinToneNames {
+		if value == name || strings.ReplaceAll(value, "_", "-") == name {
+			return SkinTone(i), nil
+		}
+	}
+
+	return SkinToneNone, fmt.Errorf("unknown skin tone %q (expected 1-5 or light, medium-light, medium, medium-dark, dark)", value)
+}
+
+// skinToneOf returns the tone for a skin tone modifier character
+func skinToneOf(char rune) SkinTone {
+	if char < firstSkinToneModifier || char > firstSkinToneModifier+4 {
+		return SkinToneNone
+	}
+
+	return SkinTone(char-firstSkinToneModifier) + SkinToneLight
+}
+
+// Gender selects the gendered form of emoji that have one
+type Gender int
+
+const (
+	// GenderNeutral leaves emoji in their gender-neutral form
+	GenderNeutral Gender = iota
+	// GenderFemale uses the woman form, e.g. 🏃‍♀️ or 👩‍💻
+	GenderFemale
+	// GenderMale uses the man form, e.g. 🏃‍♂️ or 👨‍💻
+	GenderMale
+)
+
+// String returns the name of the gender
+func (g Gender) String() string {
+	switch g {
+	case GenderFemale:
+		return "female"
+	case GenderMale:
+		return "male"
+	default:
+		return "neutral"
+	}
+}
+
+// ParseGender converts a gender name into a Gender
+func ParseGender(value string) (Gender, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "neutral", "person":
+		return GenderNeutral, nil
+	case "female", "woman", "f":
+		return GenderFemale, nil
+	case "male", "man", "m":
+		return GenderMale, nil
+	default:
+		return GenderNeutral, fmt.Errorf("unknown gender %q (expected neutral, female or male)", value)
+	}
+}
+
+const (
+	femaleSign = '♀'
+	maleSign   = '♂'
+	person     = '\U0001F9D1'
+	woman      = '\U0001F469'
+	man        = '\U0001F468'
+)
+
+var (
+	modifierOnce sync.Once
+	// skinToneVariants maps an emoji without modifiers or variation selectors
+	// to the UnicodeEntries index of each toned form, offset by one
+	skinToneVariants map[string][5]int
+	// genderVariants maps a gender-neutral emoji without variation selectors
+	// to the UnicodeEntries index of its female and male forms, offset by one
+	genderVariants map[string][2]int
+)
+
+// buildModifierIndex derives skin tone and gender support from the Unicode data
+func buildModifierIndex() {
+	modifierOnce.Do(func() {
+		buildUnicodeIndex()
+
+		skinToneVariants = make(map[string][5]int)
+		genderVariants = make(map[string][2]int)
+
+		for i, entry := range UnicodeEntries {
+			if entry.Status != StatusFullyQualified {
+				continue
+			}
+
+			stripped := StripVariationSelectors(entry.Emoji)
+			untoned, tone, ok := splitSkinTone(stripped)
+			if !ok {
+				continue
+			}
+
+			if tone != SkinToneNone {
+				variants := skinToneVariants[untoned]
+				variants[tone-SkinToneLight] = i + 1
+				skinToneVariants[untoned] = variants
+				continue
+			}
+
+			if base, gender := neutralForm(stripped); gender != GenderNeutral {
+				if _, exists := unicodeByBase[base]; exists {
+					variants := genderVariants[base]
+					variants[gender-GenderFemale] = i + 1
+					genderVariants[base] = variants
+				}
+			}
+		}
+	})
+}
+
+// splitSkinTone removes skin tone modifiers from an emoji
+//
+// ok is false when the emoji mixes different tones, as in some
+// multi-person sequences, since those cannot be chosen by a single tone.
+func splitSkinTone(emoji string) (untoned string, tone SkinTone, ok bool) {
+	var builder strings.Builder
+
+	for _, char := range emoji {
+		if charTone := skinToneOf(char); charTone != SkinToneNone {
+			if tone != SkinToneNone && tone != charTone {
+				return "", SkinToneNone, false
+			}
+
+			tone = charTone
+			continue
+		}
+
+		builder.WriteRune(char)
+	}
+
+	return builder.String(), tone, true
+}
+
+// neutralForm returns the gender-neutral base of a gendered emoji
+func neutralForm(emoji string) (string, Gender) {
+	if last, size := utf8.DecodeLastRuneInString(emoji); last == femaleSign || last == maleSign {
+		rest := emoji[:len(emoji)-size]
+		if joiner, joinerSize := utf8.DecodeLastRuneInString(rest); joiner == ZeroWidthJoiner {
+			if last == femaleSign {
+				return rest[:len(rest)-joinerSize], GenderFemale
+			}
+
+			return rest[:len(rest)-joinerSize], GenderMale
+		}
+	}
+
+	// Professions such as 👩‍💻 are built from a person, woman or man and a ZWJ
+	first, size := utf8.DecodeRuneInString(emoji)
+	if first != woman && first != man {
+		return emoji, GenderNeutral
+	}
+
+	if joiner, _ := utf8.DecodeRuneInString(emoji[size:]); joiner != ZeroWidthJoiner {
+		return emoji, GenderNeutral
+	}
+
+	neutral := string(person) + emoji[size:]
+	if first == woman {
+		return neutral, GenderFemale
+	}
+
+	return neutral, GenderMale
+}
+
+// HasSkinTone reports whether an emoji already contains a skin tone modifier
+func HasSkinTone(emoji string) bool {
+	for _, char := range emoji {
+		if skinToneOf(char) != SkinToneNone {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsSkinTone reports whether an emoji has skin tone variants
+func SupportsSkinTone(emoji string) bool {
+	buildModifierIndex()
+
+	_, exists := skinToneVariants[StripVariationSelectors(emoji)]
+	return exists && !HasSkinTone(emoji)
+}
+
+// ApplySkinTone returns the given skin tone variant of an emoji
+//
+// Emoji that do not support skin tones, or already have one, are returned
+// unchanged with ok set to false.
+func ApplySkinTone(emoji string, tone SkinTone) (string, bool) {
+	if tone == SkinToneNone || HasSkinTone(emoji) {
+		return emoji, false
+	}
+
+	buildModifierIndex()
+
+	variants, exists := skinToneVariants[StripVariationSelectors(emoji)]
+	if !exists || tone > SkinToneDark || variants[tone-SkinToneLight] == 0 {
+		return emoji, false
+	}
+
+	return UnicodeEntries[variants[tone-SkinToneLight]-1].Emoji, true
+}
+
+// SupportsGender reports whether a gender-neutral emoji has female and male variants
+func SupportsGender(emoji string) bool {
+	buildModifierIndex()
+
+	_, exists := genderVariants[StripVariationSelectors(emoji)]
+	return exists
+}
+
+// ApplyGender returns the given gendered variant of a gender-neutral emoji
+//
+// Emoji without gendered variants are returned unchanged with ok set to false.
+func ApplyGender(emoji string, gender Gender) (string, bool) {
+	if gender != GenderFemale && gender != GenderMale {
+		return emoji, false
+	}
+
+	buildModifierIndex()
+
+	untoned, tone, ok := splitSkinTone(StripVariationSelectors(emoji))
+	if !ok {
+		return emoji, false
+	}
+
+	variants, exists := genderVariants[untoned]
+	if !exists || variants[gender-GenderFemale] == 0 {
+		return emoji, false
+	}
+
+	gendered := UnicodeEntries[variants[gender-GenderFemale]-1].Emoji
+	if tone != SkinToneNone {
+		// Keep the skin tone of the original emoji
+		if toned, ok := ApplySkinTone(gendered, tone); ok {
+			return toned, true
+		}
+
+		return emoji, false
+	}
+
+	return gendered, true
+}
+
+// SplitToneAlias splits an alias such as ":wave_tone3:" into ":wave:" and its skin tone
+func SplitToneAlias(alias string) (string, SkinTone, bool) {
+	const suffix = "_toneN:"
+
+	if len(alias) <= len(suffix)+1 || !strings.HasPrefix(alias, ":") {
+		return alias, SkinToneNone, false
+	}
+
+	tail := alias[len(alias)-len(suffix):]
+	if !strings.HasPrefix(tail, "_tone") || !strings.HasSuffix(tail, ":") {
+		return alias, SkinToneNone, false
+	}
+
+	tone, err := ParseSkinTone(tail[5:6])
+	if err != nil || tone == SkinToneNone {
+		return alias, SkinToneNone, false
+	}
+
+	return alias[:len(alias)-len(suffix)] + ":", tone, true
+}
+
+// ParseSkinToneAlias parses a standalone modifier alias such as ":skin-tone-4:"
+//
+// Tones are numbered 2-6 as in Slack, after the Fitzpatrick types the
+// modifiers stand for, so ":skin-tone-2:" is light. The _toneN aliases and
+// ParseSkinTone number the same tones 1-5.
+func ParseSkinToneAlias(alias string) (SkinTone, bool) {
+	const prefix = ":skin-tone-"
+
+	if len(alias) != len(prefix)+2 || !strings.HasPrefix(alias, prefix) || !strings.HasSuffix(alias, ":") {
+		return SkinToneNone, false
+	}
+
+	digit := alias[len(prefix)]
+	if digit < '2' || digit > '6' {
+		return SkinToneNone, false
+	}
+
+	return SkinTone(digit-'2') + SkinToneLight, true
+}
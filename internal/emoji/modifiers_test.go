@@ -0,0 +1,177 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// ModifiersTestSuite defines the test suite for skin tone and gender modifiers
+type ModifiersTestSuite struct {
+	suite.Suite
+}
+
+// TestParseSkinTone tests parsing of tone numbers and names
+func (suite *ModifiersTestSuite) TestParseSkinTone() {
+	tests := []struct {
+		value    string
+		expected SkinTone
+	}{
+		{value: "", expected: SkinToneNone},
+		{value: "0", expected: SkinToneNone},
+		{value: "none", expected: SkinToneNone},
+		{value: "1", expected: SkinToneLight},
+		{value: "3", expected: SkinToneMedium},
+		{value: "5", expected: SkinToneDark},
+		{value: "medium-light", expected: SkinToneMediumLight},
+		{value: "Medium_Dark", expected: SkinToneMediumDark},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.value, func() {
+			tone, err := ParseSkinTone(tt.value)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, tone)
+		})
+	}
+
+	for _, value := range []string{"6", "-1", "tan"} {
+		_, err := ParseSkinTone(value)
+		assert.Error(suite.T(), err, "Value %q", value)
+	}
+
+	assert.Equal(suite.T(), '\U0001F3FD', SkinToneMedium.Modifier())
+	assert.Equal(suite.T(), rune(0), SkinToneNone.Modifier())
+	assert.Equal(suite.T(), "medium-dark", SkinToneMediumDark.String())
+}
+
+// TestParseGender tests parsing of gender names
+func (suite *ModifiersTestSuite) TestParseGender() {
+	for value, expected := range map[string]Gender{
+		"":        GenderNeutral,
+		"neutral": GenderNeutral,
+		"female":  GenderFemale,
+		"Woman":   GenderFemale,
+		"male":    GenderMale,
+		"m":       GenderMale,
+	} {
+		gender, err := ParseGender(value)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, gender, "Value %q", value)
+	}
+
+	_, err := ParseGender("other")
+	assert.Error(suite.T(), err)
+}
+
+// TestApplySkinTone tests composition of skin tone variants
+func (suite *ModifiersTestSuite) TestApplySkinTone() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		emoji    string
+		tone     SkinTone
+		expected string
+		ok       bool
+	}{
+		{name: "single character", emoji: "👋", tone: SkinToneMedium, expected: "👋🏽", ok: true},
+		{name: "variation selector base", emoji: "✌️", tone: SkinToneDark, expected: "✌🏿", ok: true},
+		{name: "ZWJ profession", emoji: "🧑‍💻", tone: SkinToneLight, expected: "🧑🏻‍💻", ok: true},
+		{name: "gendered sequence", emoji: "🏃‍♀️", tone: SkinToneMediumDark, expected: "🏃🏾‍♀️", ok: true},
+		{name: "multi-person sequence", emoji: "🧑‍🤝‍🧑", tone: SkinToneMedium, expected: "🧑🏽‍🤝‍🧑🏽", ok: true},
+		{name: "no modifier support", emoji: "😄", tone: SkinToneMedium, expected: "😄", ok: false},
+		{name: "already toned", emoji: "👋🏻", tone: SkinToneDark, expected: "👋🏻", ok: false},
+		{name: "no tone", emoji: "👋", tone: SkinToneNone, expected: "👋", ok: false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, ok := ApplySkinTone(tt.emoji, tt.tone)
+			assert.Equal(suite.T(), tt.expected, result)
+			assert.Equal(suite.T(), tt.ok, ok)
+		})
+	}
+
+	assert.True(suite.T(), SupportsSkinTone("👍"))
+	assert.False(suite.T(), SupportsSkinTone("👍🏽"))
+	assert.False(suite.T(), SupportsSkinTone("🚀"))
+	assert.True(suite.T(), HasSkinTone("👍🏽"))
+}
+
+// TestApplyGender tests composition of gendered variants
+func (suite *ModifiersTestSuite) TestApplyGender() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		emoji    string
+		gender   Gender
+		expected string
+		ok       bool
+	}{
+		{name: "gender sign female", emoji: "🏃", gender: GenderFemale, expected: "🏃‍♀️", ok: true},
+		{name: "gender sign male", emoji: "🤷", gender: GenderMale, expected: "🤷‍♂️", ok: true},
+		{name: "profession", emoji: "🧑‍💻", gender: GenderFemale, expected: "👩‍💻", ok: true},
+		{name: "keeps skin tone", emoji: "🏃🏽", gender: GenderMale, expected: "🏃🏽‍♂️", ok: true},
+		{name: "neutral", emoji: "🏃", gender: GenderNeutral, expected: "🏃", ok: false},
+		{name: "no gendered form", emoji: "🚀", gender: GenderFemale, expected: "🚀", ok: false},
+		{name: "already gendered", emoji: "👨‍💻", gender: GenderFemale, expected: "👨‍💻", ok: false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, ok := ApplyGender(tt.emoji, tt.gender)
+			assert.Equal(suite.T(), tt.expected, result)
+			assert.Equal(suite.T(), tt.ok, ok)
+		})
+	}
+
+	assert.True(suite.T(), SupportsGender("🏃"))
+	assert.False(suite.T(), SupportsGender("🏃‍♀️"))
+}
+
+// TestSplitToneAlias tests parsing of _toneN aliases
+func (suite *ModifiersTestSuite) TestSplitToneAlias() {
+	base, tone, ok := SplitToneAlias(":thumbsup_tone4:")
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), ":thumbsup:", base)
+	assert.Equal(suite.T(), SkinToneMediumDark, tone)
+
+	for _, alias := range []string{":thumbsup:", ":_tone1:", ":wave_tone6:", ":wave_tone1", "wave_tone1:"} {
+		_, _, ok := SplitToneAlias(alias)
+		assert.False(suite.T(), ok, "Alias %q", alias)
+	}
+}
+
+// TestParseSkinToneAlias tests parsing of inline :skin-tone-N: modifiers
+func (suite *ModifiersTestSuite) TestParseSkinToneAlias() {
+	// Slack numbers the tones 2-6, one more than the _toneN aliases
+	for alias, expected := range map[string]SkinTone{
+		":skin-tone-2:": SkinToneLight,
+		":skin-tone-3:": SkinToneMediumLight,
+		":skin-tone-4:": SkinToneMedium,
+		":skin-tone-5:": SkinToneMediumDark,
+		":skin-tone-6:": SkinToneDark,
+	} {
+		tone, ok := ParseSkinToneAlias(alias)
+		assert.True(suite.T(), ok, "Alias %q", alias)
+		assert.Equal(suite.T(), expected, tone, "Alias %q", alias)
+	}
+
+	for _, alias := range []string{":skin-tone-0:", ":skin-tone-1:", ":skin-tone-7:", ":skin-tone-:", ":skin_tone_2:"} {
+		_, ok := ParseSkinToneAlias(alias)
+		assert.False(suite.T(), ok, "Alias %q", alias)
+	}
+}
+
+// TestModifiers runs all modifier tests
+func TestModifiers(t *testing.T) {
+	suite.Run(t, new(ModifiersTestSuite))
+}
@@ -0,0 +1,138 @@
+package emoji
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// TextVariationSelector (VS15) requests text presentation of the preceding character
+	TextVariationSelector = '\uFE0E'
+
+	// EmojiVariationSelector (VS16) requests emoji presentation of the preceding character
+	EmojiVariationSelector = '\uFE0F'
+
+	// ZeroWidthJoiner joins emoji into a single ZWJ sequence
+	ZeroWidthJoiner = '\u200D'
+
+	// CombiningKeycap turns a digit, "#" or "*" into a keycap emoji
+	CombiningKeycap = '\u20E3'
+)
+
+// Presentation controls the variation selectors used for encoded emoji
+type Presentation int
+
+const (
+	// PresentationDefault keeps emoji exactly as they appear in the alias database
+	PresentationDefault Presentation = iota
+	// PresentationEmoji uses the fully-qualified form, adding VS16 where valid
+	PresentationEmoji
+	// PresentationText uses VS15 for emoji that have a text form
+	PresentationText
+	// PresentationStrip removes all variation selectors
+	PresentationStrip
+)
+
+// String returns the name used for the presentation on the command line
+func (p Presentation) String() string {
+	switch p {
+	case PresentationEmoji:
+		return "emoji"
+	case PresentationText:
+		return "text"
+	case PresentationStrip:
+		return "strip"
+	default:
+		return "default"
+	}
+}
+
+// ParsePresentation converts a presentation name into a Presentation
+func ParsePresentation(name string) (Presentation, error) {
+	switch strings.ToLower(name) {
+	case "", "default":
+		return PresentationDefault, nil
+	case "emoji":
+		return PresentationEmoji, nil
+	case "text":
+		return PresentationText, nil
+	case "strip", "none":
+		return PresentationStrip, nil
+	default:
+		return PresentationDefault, fmt.Errorf("unknown presentation %q (expected emoji, text or strip)", name)
+	}
+}
+
+// ApplyPresentation rewrites the variation selectors of an emoji
+//
+// Text presentation only applies to emoji that have a text form, such as
+// "✈" or "#⃣"; ZWJ and skin tone sequences are returned in emoji form.
+func ApplyPresentation(emoji string, presentation Presentation) string {
+	switch presentation {
+	case PresentationEmoji:
+		if qualified, ok := FullyQualified(emoji); ok {
+			return qualified
+		}
+
+		return emoji
+	case PresentationText:
+		return textPresentation(emoji)
+	case PresentationStrip:
+		return StripVariationSelectors(emoji)
+	default:
+		return emoji
+	}
+}
+
+// FullyQualified returns the recommended form of an emoji, with VS16 wherever it is required
+func FullyQualified(emoji string) (string, bool) {
+	buildUnicodeIndex()
+
+	if i, exists := unicodeByBase[StripVariationSelectors(emoji)]; exists {
+		return UnicodeEntries[i].Emoji, true
+	}
+
+	return "", false
+}
+
+// HasTextPresentation reports whether a character accepts a VS15/VS16 variation selector
+func HasTextPresentation(char rune) bool {
+	buildUnicodeIndex()
+	return variationBases[char]
+}
+
+// textPresentation returns the VS15 form of an emoji, if it has one
+func textPresentation(emoji string) string {
+	stripped := StripVariationSelectors(emoji)
+
+	first, size := utf8.DecodeRuneInString(stripped)
+	if !HasTextPresentation(first) || strings.ContainsRune(stripped, ZeroWidthJoiner) {
+		return emoji
+	}
+
+	rest := stripped[size:]
+	for _, char := range rest {
+		// Only keycaps combine a text-capable base with further characters
+		if char != CombiningKeycap {
+			return emoji
+		}
+	}
+
+	return string(first) + string(TextVariationSelector) + rest
+}
+
+// StripVariationSelectors removes VS15 and VS16 from an emoji string
+func StripVariationSelectors(emoji string) string {
+	if !strings.ContainsRune(emoji, TextVariationSelector) && !strings.ContainsRune(emoji, EmojiVariationSelector) {
+		return emoji
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r == TextVariationSelector || r == EmojiVariationSelector {
+			return -1
+		}
+
+		return r
+	}, emoji)
+}
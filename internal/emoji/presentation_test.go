@@ -0,0 +1,118 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// PresentationTestSuite defines the test suite for variation selector handling
+type PresentationTestSuite struct {
+	suite.Suite
+}
+
+// TestParsePresentation tests parsing of presentation names
+func (suite *PresentationTestSuite) TestParsePresentation() {
+	tests := []struct {
+		name     string
+		expected Presentation
+	}{
+		{name: "", expected: PresentationDefault},
+		{name: "default", expected: PresentationDefault},
+		{name: "emoji", expected: PresentationEmoji},
+		{name: "TEXT", expected: PresentationText},
+		{name: "strip", expected: PresentationStrip},
+		{name: "none", expected: PresentationStrip},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			presentation, err := ParsePresentation(tt.name)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, presentation)
+		})
+	}
+
+	_, err := ParsePresentation("colour")
+	assert.Error(suite.T(), err)
+
+	assert.Equal(suite.T(), "text", PresentationText.String())
+}
+
+// TestApplyPresentation tests rewriting of variation selectors
+func (suite *PresentationTestSuite) TestApplyPresentation() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name         string
+		emoji        string
+		presentation Presentation
+		expected     string
+	}{
+		{name: "default keeps selector", emoji: "✈️", presentation: PresentationDefault, expected: "✈️"},
+		{name: "default keeps bare", emoji: "✈", presentation: PresentationDefault, expected: "✈"},
+		{name: "emoji adds VS16", emoji: "✈", presentation: PresentationEmoji, expected: "✈️"},
+		{name: "emoji replaces VS15", emoji: "✈︎", presentation: PresentationEmoji, expected: "✈️"},
+		{name: "emoji qualifies ZWJ sequence", emoji: "🏳‍🌈", presentation: PresentationEmoji, expected: "🏳️‍🌈"},
+		{name: "emoji leaves emoji-only characters", emoji: "😄", presentation: PresentationEmoji, expected: "😄"},
+		{name: "text replaces VS16", emoji: "✈️", presentation: PresentationText, expected: "✈︎"},
+		{name: "text adds VS15", emoji: "✈", presentation: PresentationText, expected: "✈︎"},
+		{name: "text keycap", emoji: "#️⃣", presentation: PresentationText, expected: "#︎⃣"},
+		{name: "text leaves emoji-only characters", emoji: "😄", presentation: PresentationText, expected: "😄"},
+		{name: "text leaves ZWJ sequences", emoji: "🏳️‍🌈", presentation: PresentationText, expected: "🏳️‍🌈"},
+		{name: "strip removes VS16", emoji: "✈️", presentation: PresentationStrip, expected: "✈"},
+		{name: "strip removes VS15", emoji: "✈︎", presentation: PresentationStrip, expected: "✈"},
+		{name: "strip ZWJ sequence", emoji: "🏳️‍🌈", presentation: PresentationStrip, expected: "🏳‍🌈"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, ApplyPresentation(tt.emoji, tt.presentation))
+		})
+	}
+}
+
+// TestHasTextPresentation tests detection of characters with a text form
+func (suite *PresentationTestSuite) TestHasTextPresentation() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	assert.True(suite.T(), HasTextPresentation('✈'))
+	assert.True(suite.T(), HasTextPresentation('❤'))
+	assert.True(suite.T(), HasTextPresentation('#'))
+	assert.False(suite.T(), HasTextPresentation('😄'))
+	assert.False(suite.T(), HasTextPresentation('a'))
+}
+
+// TestStripVariationSelectors tests removal of VS15 and VS16
+func (suite *PresentationTestSuite) TestStripVariationSelectors() {
+	assert.Equal(suite.T(), "✈", StripVariationSelectors("✈️"))
+	assert.Equal(suite.T(), "✈", StripVariationSelectors("✈︎"))
+	assert.Equal(suite.T(), "😄", StripVariationSelectors("😄"))
+	assert.Equal(suite.T(), "", StripVariationSelectors(""))
+}
+
+// TestDecodeAcceptsAllForms tests that every presentation decodes to the same alias
+func (suite *PresentationTestSuite) TestDecodeAcceptsAllForms() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	for _, form := range []string{"✈️", "✈︎", "✈"} {
+		assert.Equal(suite.T(), ":airplane:", GetAlias(form), "Form %q", form)
+	}
+
+	for _, form := range []string{"❤️", "❤︎", "❤"} {
+		assert.Equal(suite.T(), ":heart:", GetAlias(form), "Form %q", form)
+	}
+}
+
+// TestPresentation runs all presentation tests
+func TestPresentation(t *testing.T) {
+	suite.Run(t, new(PresentationTestSuite))
+}
@@ -3,54 +3,67 @@
 import (
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"io"
+	"os"
+	"sort"
 	"strings"
 )
 
+// GemojiURL is the location of GitHub's gemoji database
+const GemojiURL = "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json"
+
 // GemojiEntry represents a single emoji entry from GitHub's gemoji API
 type GemojiEntry struct {
-	Emoji   string   `json:"emoji"`
-	Aliases []string `json:"aliases"`
+	Emoji          string   `json:"emoji"`
+	Aliases        []string `json:"aliases"`
+	UnicodeVersion string   `json:"unicode_version"`
 }
 
 // ScraperResult holds the result of scraping emoji data
 type ScraperResult struct {
 	EmojiCount int
 	Data       map[string]string
+	// Versions maps each emoji to the Unicode version gemoji lists for it
+	Versions map[string]string
 }
 
-// ScrapeGitHubEmojis fetches emoji data from GitHub's gemoji repository
-func ScrapeGitHubEmojis() (*ScraperResult, error) {
-	const gemojiURL = "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json"
-
-	resp, err := http.Get(gemojiURL)
+// LoadGemojiFile reads emoji data from a local copy of gemoji's emoji.json
+func LoadGemojiFile(path string) (*ScraperResult, error) {
+	file, err := os.Open(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch emoji data: %w", err)
+		return nil, fmt.Errorf("failed to open emoji data: %w", err)
 	}
 
-	defer resp.Body.Close()
+	defer file.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
+	return ParseGemojiJSON(file)
+}
 
+// ParseGemojiJSON decodes emoji data in gemoji's emoji.json format
+func ParseGemojiJSON(r io.Reader) (*ScraperResult, error) {
 	var entries []GemojiEntry
-	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
 		return nil, fmt.Errorf("failed to decode JSON: %w", err)
 	}
 
 	emojiMap := make(map[string]string)
+	versions := make(map[string]string)
 
 	for _, entry := range entries {
 		for _, alias := range entry.Aliases {
 			emojiAlias := fmt.Sprintf(":%s:", alias)
 			emojiMap[emojiAlias] = entry.Emoji
 		}
+
+		if entry.UnicodeVersion != "" {
+			versions[entry.Emoji] = entry.UnicodeVersion
+		}
 	}
 
 	return &ScraperResult{
 		EmojiCount: len(emojiMap),
 		Data:       emojiMap,
+		Versions:   versions,
 	}, nil
 }
 
@@ -77,3 +90,28 @@ func GenerateGoCode(data map[string]string) string {
 
 	return builder.String()
 }
+
+// GenerateVersionsGoCode generates Go code for the Unicode versions gemoji lists
+func GenerateVersionsGoCode(versions map[string]string) string {
+	var builder strings.Builder
+
+	builder.WriteString("// Code generated by emojify-scraper versions; DO NOT EDIT.\n\n")
+	builder.WriteString("package emoji\n\n")
+	builder.WriteString("// GemojiVersions maps emoji to the Unicode version gemoji lists for them\n")
+	builder.WriteString("var GemojiVersions = map[string]string{\n")
+
+	emojis := make([]string, 0, len(versions))
+	for emoji := range versions {
+		emojis = append(emojis, emoji)
+	}
+
+	sort.Strings(emojis)
+
+	for _, emoji := range emojis {
+		builder.WriteString(fmt.Sprintf("\t%q: %q,\n", emoji, versions[emoji]))
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
@@ -0,0 +1,26 @@
+//go:build !js && !wasip1
+
+package emoji
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ScrapeGitHubEmojis fetches emoji data from GitHub's gemoji repository
+//
+// It is left out of WebAssembly builds, which would otherwise link net/http.
+func ScrapeGitHubEmojis() (*ScraperResult, error) {
+	resp, err := http.Get(GemojiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch emoji data: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return ParseGemojiJSON(resp.Body)
+}
@@ -0,0 +1,315 @@
+package emoji
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Info describes an emoji and every alias that produces it
+type Info struct {
+	Emoji string `json:"emoji"`
+	// Alias is the alias the emoji decodes to, or the alias that matched a search
+	Alias          string   `json:"alias"`
+	Aliases        []string `json:"aliases"`
+	Name           string   `json:"name,omitempty"`
+	Group          string   `json:"group,omitempty"`
+	Subgroup       string   `json:"subgroup,omitempty"`
+	UnicodeVersion string   `json:"unicode_version,omitempty"`
+	Codepoints     string   `json:"codepoints"`
+	// Width is the number of terminal columns the emoji occupies
+	Width int `json:"width"`
+	// Gitmoji is the meaning of the emoji in the gitmoji commit convention, if it has one
+	Gitmoji string `json:"gitmoji,omitempty"`
+	// LocalAlias, LocalName and Keywords are the CLDR annotation in the requested locale
+	LocalAlias string   `json:"local_alias,omitempty"`
+	LocalName  string   `json:"local_name,omitempty"`
+	Keywords   []string `json:"keywords,omitempty"`
+}
+
+var (
+	aliasesOnce sync.Once
+	// aliasesByEmoji groups every alias by its emoji, ignoring variation selectors
+	aliasesByEmoji map[string][]string
+)
+
+// buildAliasIndex groups EmojiMap and CLDR-derived aliases by emoji
+func buildAliasIndex() {
+	aliasesOnce.Do(func() {
+		aliasesByEmoji = make(map[string][]string)
+
+		for _, alias := range Aliases() {
+			key := StripVariationSelectors(GetEmoji(alias))
+			if key == "" {
+				continue
+			}
+
+			aliasesByEmoji[key] = append(aliasesByEmoji[key], alias)
+		}
+
+		for _, aliases := range aliasesByEmoji {
+			sort.Strings(aliases)
+		}
+	})
+}
+
+// Lookup returns information about an alias or emoji
+//
+// Aliases may be given with or without their surrounding colons.
+func Lookup(query string) (Info, bool) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return Info{}, false
+	}
+
+	if HasEmojiCharacters(query) {
+		if emoji, _, ok := MatchEmoji(query); ok && emoji == query {
+			return newInfo(query, GetAlias(query)), true
+		}
+
+		return Info{}, false
+	}
+
+	alias := normalizeAlias(query)
+	emoji := GetEmoji(alias)
+	if emoji == alias {
+		return Info{}, false
+	}
+
+	return newInfo(emoji, alias), true
+}
+
+const (
+	// DefaultSearchLimit is the number of search results returned when no limit is given
+	DefaultSearchLimit = 20
+
+	// MaxSearchLimit caps the number of search results a client can request
+	MaxSearchLimit = 500
+)
+
+// Search finds emoji whose aliases, names or gitmoji descriptions contain every word of the query
+//
+// Results are ordered by relevance: exact alias matches first, then alias
+// prefixes, then other alias and name matches, with shorter aliases first
+// within each group. A limit of zero or less returns every match.
+func Search(query string, limit int) []Info {
+	return search(query, limit, nil)
+}
+
+// search implements Search, also matching the aliases, names and keywords of locale if it isn't nil
+func search(query string, limit int, locale *Locale) []Info {
+	terms := strings.Fields(strings.ToLower(strings.Trim(strings.TrimSpace(query), ":")))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	buildAliasIndex()
+
+	type match struct {
+		info  Info
+		score int
+	}
+
+	var matches []match
+	for _, aliases := range aliasesByEmoji {
+		emoji := GetEmoji(aliases[0])
+		name := ""
+		if entry, ok := LookupUnicode(emoji); ok {
+			name = strings.ToLower(entry.Name)
+		}
+
+		if gitmoji, ok := LookupGitmoji(emoji); ok {
+			name += " " + strings.ToLower(gitmoji.Description)
+		}
+
+		candidates := aliases
+		if locale != nil {
+			if entry, ok := locale.Entry(emoji); ok {
+				name += " " + strings.ToLower(entry.Name+" "+strings.Join(entry.Keywords, " "))
+				candidates = append([]string{entry.Alias}, aliases...)
+			}
+		}
+
+		bestScore, bestAlias := 0, ""
+		for _, alias := range candidates {
+			if score := scoreMatch(terms, strings.Trim(alias, ":"), name); score > bestScore {
+				bestScore, bestAlias = score, alias
+			}
+		}
+
+		if bestScore > 0 {
+			matches = append(matches, match{info: newInfo(emoji, bestAlias), score: bestScore})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+
+		// Prefer short aliases, which are usually the base emoji rather than a variant
+		if len(matches[i].info.Alias) != len(matches[j].info.Alias) {
+			return len(matches[i].info.Alias) < len(matches[j].info.Alias)
+		}
+
+		return matches[i].info.Alias < matches[j].info.Alias
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	results := make([]Info, len(matches))
+	for i, m := range matches {
+		results[i] = m.info
+	}
+
+	return results
+}
+
+// scoreMatch rates how well an alias (without colons) and descriptive text match the search terms
+func scoreMatch(terms []string, alias, name string) int {
+	query := strings.Join(terms, "_")
+
+	switch {
+	case alias == query:
+		return 4
+	case strings.HasPrefix(alias, query):
+		return 3
+	}
+
+	for _, term := range terms {
+		if !strings.Contains(alias, term) && !strings.Contains(name, term) {
+			return 0
+		}
+	}
+
+	if strings.Contains(alias, query) {
+		return 2
+	}
+
+	return 1
+}
+
+// newInfo builds the Info for an emoji, reporting alias as its primary alias
+func newInfo(emoji, alias string) Info {
+	buildAliasIndex()
+
+	version, _ := UnicodeVersionOf(emoji)
+
+	info := Info{
+		Emoji:          emoji,
+		Alias:          alias,
+		Aliases:        slices.Clone(aliasesByEmoji[StripVariationSelectors(emoji)]),
+		UnicodeVersion: version,
+		Codepoints:     formatCodepoints(emoji),
+		Width:          Width(emoji),
+	}
+
+	if entry, ok := LookupUnicode(emoji); ok {
+		info.Name = entry.Name
+		info.Group = entry.Group
+		info.Subgroup = entry.Subgroup
+	}
+
+	if gitmoji, ok := LookupGitmoji(emoji); ok {
+		info.Gitmoji = gitmoji.Description
+	}
+
+	if info.Aliases == nil {
+		info.Aliases = []string{alias}
+	}
+
+	return info
+}
+
+// normalizeAlias wraps an alias in colons if they are missing
+func normalizeAlias(alias string) string {
+	if !strings.HasPrefix(alias, ":") {
+		alias = ":" + alias
+	}
+
+	if len(alias) == 1 || !strings.HasSuffix(alias, ":") {
+		alias += ":"
+	}
+
+	return alias
+}
+
+// ClosestAlias suggests the known alias nearest to a misspelled one, such as :rocket: for :rocekt:
+//
+// Only aliases within a small edit distance are suggested, so ok is false
+// for text that doesn't resemble any alias.
+func ClosestAlias(alias string) (string, bool) {
+	target := strings.ToLower(strings.Trim(alias, ":"))
+	if target == "" {
+		return "", false
+	}
+
+	// Allow one edit for short aliases and roughly one per four characters otherwise
+	limit := max(1, len(target)/4)
+
+	best, bestDistance := "", limit+1
+	for _, candidate := range Aliases() {
+		name := strings.Trim(candidate, ":")
+		if abs(len(name)-len(target)) > limit {
+			continue
+		}
+
+		if distance := editDistance(target, name, bestDistance); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	return best, best != ""
+}
+
+// editDistance returns the optimal string alignment distance between a and b, or limit if it is at least limit
+//
+// Like the Levenshtein distance, but swapping two adjacent characters counts as one edit.
+func editDistance(a, b string, limit int) int {
+	twoBack := make([]int, len(b)+1)
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		rowMin := current[0]
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				current[j] = min(current[j], twoBack[j-2]+1)
+			}
+
+			rowMin = min(rowMin, current[j])
+		}
+
+		if rowMin >= limit {
+			return limit
+		}
+
+		twoBack, previous, current = previous, current, twoBack
+	}
+
+	return min(previous[len(b)], limit)
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
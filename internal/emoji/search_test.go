@@ -0,0 +1,129 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// SearchTestSuite defines the test suite for emoji lookup and search
+type SearchTestSuite struct {
+	suite.Suite
+}
+
+// TestLookup tests lookups by alias and by emoji
+func (suite *SearchTestSuite) TestLookup() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		alias string
+		emoji string
+	}{
+		{name: "alias with colons", query: ":rocket:", alias: ":rocket:", emoji: "🚀"},
+		{name: "alias without colons", query: "rocket", alias: ":rocket:", emoji: "🚀"},
+		{name: "secondary alias", query: "thumbsup", alias: ":thumbsup:", emoji: "👍"},
+		{name: "CLDR alias", query: "melting_face", alias: ":melting_face:", emoji: "🫠"},
+		{name: "emoji", query: "🚀", alias: ":rocket:", emoji: "🚀"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			info, ok := Lookup(tt.query)
+			require.True(suite.T(), ok)
+			assert.Equal(suite.T(), tt.alias, info.Alias)
+			assert.Equal(suite.T(), tt.emoji, info.Emoji)
+		})
+	}
+
+	info, ok := Lookup("+1")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), []string{":+1:", ":thumbs_up:", ":thumbsup:"}, info.Aliases)
+	assert.Equal(suite.T(), "thumbs up", info.Name)
+	assert.Equal(suite.T(), "People & Body", info.Group)
+	assert.Equal(suite.T(), "6.0", info.UnicodeVersion)
+	assert.Equal(suite.T(), "U+1F44D", info.Codepoints)
+	assert.Empty(suite.T(), info.Gitmoji)
+
+	info, ok = Lookup("bug")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), "Fix a bug", info.Gitmoji)
+
+	for _, query := range []string{"", "not_an_emoji", "🚀🚀", "a🚀"} {
+		_, ok := Lookup(query)
+		assert.False(suite.T(), ok, "Query %q", query)
+	}
+}
+
+// TestSearch tests matching and ranking of search results
+func (suite *SearchTestSuite) TestSearch() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	results := Search("rocket", 0)
+	require.NotEmpty(suite.T(), results)
+	assert.Equal(suite.T(), ":rocket:", results[0].Alias, "Exact alias matches come first")
+
+	results = Search("thumbs", 2)
+	require.Len(suite.T(), results, 2)
+	assert.Equal(suite.T(), ":thumbs_up:", results[0].Alias)
+	assert.Equal(suite.T(), ":thumbs_down:", results[1].Alias)
+
+	// Every word must match the alias or the name
+	results = Search("cat face crying", 0)
+	require.NotEmpty(suite.T(), results)
+	assert.Equal(suite.T(), "😿", results[0].Emoji)
+
+	// Each emoji appears once, even when several aliases match
+	seen := make(map[string]bool)
+	for _, result := range Search("heart", 0) {
+		assert.False(suite.T(), seen[result.Emoji], "Duplicate result %s", result.Emoji)
+		seen[result.Emoji] = true
+	}
+
+	// Gitmoji descriptions are searchable
+	results = Search("introduce new features", 0)
+	require.Len(suite.T(), results, 1)
+	assert.Equal(suite.T(), ":sparkles:", results[0].Alias)
+	assert.Equal(suite.T(), "Introduce new features", results[0].Gitmoji)
+
+	assert.Empty(suite.T(), Search("", 10))
+	assert.Empty(suite.T(), Search("zzzzqqq", 10))
+}
+
+// TestClosestAlias tests suggestions for misspelled aliases
+func (suite *SearchTestSuite) TestClosestAlias() {
+	tests := []struct {
+		alias    string
+		expected string
+	}{
+		{alias: ":rocekt:", expected: ":rocket:"},
+		{alias: ":sparkels:", expected: ":sparkles:"},
+		{alias: "thumbsupp", expected: ":thumbsup:"},
+		{alias: ":Sparkles:", expected: ":sparkles:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.alias, func() {
+			suggestion, ok := ClosestAlias(tt.alias)
+			require.True(suite.T(), ok)
+			assert.Equal(suite.T(), tt.expected, suggestion)
+		})
+	}
+
+	for _, alias := range []string{"", ":zzzzqqqq:", ":completely_different_words:"} {
+		_, ok := ClosestAlias(alias)
+		assert.False(suite.T(), ok, "Alias %q", alias)
+	}
+}
+
+// TestSearch runs all search tests
+func TestSearch(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
@@ -0,0 +1,501 @@
+package emoji
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Qualification statuses used by Unicode's emoji-test.txt
+const (
+	StatusComponent          = "component"
+	StatusFullyQualified     = "fully-qualified"
+	StatusMinimallyQualified = "minimally-qualified"
+	StatusUnqualified        = "unqualified"
+)
+
+// UnicodeEntry describes a single emoji sequence listed in Unicode's emoji-test.txt
+type UnicodeEntry struct {
+	Emoji    string
+	Alias    string
+	Name     string
+	Status   string
+	Group    string
+	Subgroup string
+	Version  string
+}
+
+// IsRGI reports whether the entry is part of the recommended (fully-qualified) emoji set
+func (e UnicodeEntry) IsRGI() bool {
+	return e.Status == StatusFullyQualified || e.Status == StatusComponent
+}
+
+// LoadEmojiTestFile parses a local copy of Unicode's emoji-test.txt
+func LoadEmojiTestFile(path string) ([]UnicodeEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open emoji-test.txt: %w", err)
+	}
+
+	defer file.Close()
+
+	return ParseEmojiTest(file)
+}
+
+// ParseEmojiTest parses data in the format of Unicode's emoji-test.txt
+//
+// Each data line has the form:
+//
+//	1F600 ; fully-qualified # 😀 E1.0 grinning face
+//
+// and belongs to the most recent "# group:" and "# subgroup:" header.
+func ParseEmojiTest(r io.Reader) ([]UnicodeEntry, error) {
+	var entries []UnicodeEntry
+	var group, subgroup string
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			comment := strings.TrimSpace(strings.TrimPrefix(line, "#"))
+			if value, found := strings.CutPrefix(comment, "group:"); found {
+				group = strings.TrimSpace(value)
+			} else if value, found := strings.CutPrefix(comment, "subgroup:"); found {
+				subgroup = strings.TrimSpace(value)
+			}
+
+			continue
+		}
+
+		entry, err := parseEmojiTestLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		entry.Group = group
+		entry.Subgroup = subgroup
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read emoji-test.txt: %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseEmojiTestLine parses a single data line of emoji-test.txt
+func parseEmojiTestLine(line string) (UnicodeEntry, error) {
+	fields, comment, found := strings.Cut(line, "#")
+	if !found {
+		return UnicodeEntry{}, fmt.Errorf("missing comment in %q", line)
+	}
+
+	codepoints, status, found := strings.Cut(fields, ";")
+	if !found {
+		return UnicodeEntry{}, fmt.Errorf("missing status in %q", line)
+	}
+
+	var emoji strings.Builder
+	for _, field := range strings.Fields(codepoints) {
+		value, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return UnicodeEntry{}, fmt.Errorf("invalid code point %q: %w", field, err)
+		}
+
+		emoji.WriteRune(rune(value))
+	}
+
+	status = strings.TrimSpace(status)
+	switch status {
+	case StatusComponent, StatusFullyQualified, StatusMinimallyQualified, StatusUnqualified:
+	default:
+		return UnicodeEntry{}, fmt.Errorf("unknown status %q", status)
+	}
+
+	// The comment repeats the emoji, then gives its version ("E13.0") and CLDR name
+	parts := strings.Fields(comment)
+	if len(parts) < 3 || !strings.HasPrefix(parts[1], "E") {
+		return UnicodeEntry{}, fmt.Errorf("malformed comment %q", comment)
+	}
+
+	return UnicodeEntry{
+		Emoji:   emoji.String(),
+		Name:    strings.Join(parts[2:], " "),
+		Status:  status,
+		Version: strings.TrimPrefix(parts[1], "E"),
+	}, nil
+}
+
+// cldrFolder maps the accented letters and symbols found in CLDR names to ASCII
+var cldrFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"ç", "c", "é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "î", "i", "ï", "i", "ñ", "n",
+	"ó", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "û", "u", "ü", "u", "ý", "y",
+	"’", "", "'", "", "&", " and ", "#", " hash ", "*", " asterisk ",
+)
+
+// CLDRAlias derives an emoji alias from a CLDR short name
+//
+// For example "waving hand: medium skin tone" becomes ":waving_hand_medium_skin_tone:"
+// and "flag: Côte d’Ivoire" becomes ":flag_cote_divoire:".
+func CLDRAlias(name string) string {
+	folded := cldrFolder.Replace(strings.ToLower(name))
+
+	var builder strings.Builder
+	builder.WriteRune(':')
+
+	pendingSeparator := false
+	for _, char := range folded {
+		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') {
+			if pendingSeparator && builder.Len() > 1 {
+				builder.WriteRune('_')
+			}
+
+			builder.WriteRune(char)
+			pendingSeparator = false
+		} else {
+			pendingSeparator = true
+		}
+	}
+
+	builder.WriteRune(':')
+
+	return builder.String()
+}
+
+// AssignAliases gives every fully-qualified and component entry a unique alias
+//
+// Aliases already present in reserved for a different emoji are disambiguated
+// with a numeric suffix. Minimally-qualified and unqualified entries share the
+// alias of the fully-qualified entry with the same name. An error is returned
+// if any recommended emoji is left without an alias.
+func AssignAliases(entries []UnicodeEntry, reserved map[string]string) error {
+	used := make(map[string]string, len(entries))
+	byName := make(map[string]string, len(entries))
+
+	for i := range entries {
+		entry := &entries[i]
+		if !entry.IsRGI() {
+			continue
+		}
+
+		base := CLDRAlias(entry.Name)
+		alias := base
+
+		for suffix := 2; ; suffix++ {
+			if !aliasTaken(alias, entry.Emoji, used, reserved) {
+				break
+			}
+
+			alias = fmt.Sprintf("%s_%d:", strings.TrimSuffix(base, ":"), suffix)
+		}
+
+		entry.Alias = alias
+		used[alias] = entry.Emoji
+		byName[entry.Name] = alias
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		if entry.IsRGI() {
+			continue
+		}
+
+		entry.Alias = byName[entry.Name]
+	}
+
+	return VerifyCoverage(entries)
+}
+
+// aliasTaken reports whether alias already names an emoji other than the given one
+func aliasTaken(alias, emoji string, used, reserved map[string]string) bool {
+	if _, exists := used[alias]; exists {
+		return true
+	}
+
+	if existing, exists := reserved[alias]; exists {
+		return StripVariationSelectors(existing) != StripVariationSelectors(emoji)
+	}
+
+	return alias == "::"
+}
+
+// VerifyCoverage checks that every recommended emoji has a unique alias
+func VerifyCoverage(entries []UnicodeEntry) error {
+	seen := make(map[string]string, len(entries))
+	var missing []string
+
+	for _, entry := range entries {
+		if !entry.IsRGI() {
+			continue
+		}
+
+		if entry.Alias == "" {
+			missing = append(missing, fmt.Sprintf("%s (%s)", formatCodepoints(entry.Emoji), entry.Name))
+			continue
+		}
+
+		if other, exists := seen[entry.Alias]; exists && other != entry.Emoji {
+			return fmt.Errorf("alias %s is used by both %s and %s",
+				entry.Alias, formatCodepoints(other), formatCodepoints(entry.Emoji))
+		}
+
+		seen[entry.Alias] = entry.Emoji
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%d emoji have no alias: %s", len(missing), strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// emojiToUnicodeVersion maps emoji versions to the Unicode version of their characters
+//
+// Up to Emoji 5.0 the two were numbered separately. From Emoji 11.0 onwards the
+// major versions match, and the .1 emoji releases only added sequences of
+// existing characters, so they need the preceding Unicode version.
+var emojiToUnicodeVersion = map[string]string{
+	"0.6":  "6.0",
+	"0.7":  "7.0",
+	"1.0":  "8.0",
+	"2.0":  "8.0",
+	"3.0":  "9.0",
+	"4.0":  "9.0",
+	"5.0":  "10.0",
+	"12.1": "12.0",
+	"13.1": "13.0",
+	"15.1": "15.0",
+}
+
+// UnicodeVersionOf returns the Unicode version needed to display an emoji
+//
+// The emoji version from emoji-test.txt is used where available, and the
+// version gemoji lists otherwise, which is all lite builds have.
+func UnicodeVersionOf(emoji string) (string, bool) {
+	var version string
+
+	if entry, ok := LookupUnicode(emoji); ok {
+		version = entry.Version
+	} else if gemoji, ok := GemojiVersions[emoji]; ok {
+		version = gemoji
+	} else if gemoji, ok := GemojiVersions[StripVariationSelectors(emoji)]; ok {
+		version = gemoji
+	} else {
+		return "", false
+	}
+
+	if unicodeVersion, exists := emojiToUnicodeVersion[version]; exists {
+		return unicodeVersion, true
+	}
+
+	return version, true
+}
+
+// HasUnicodeData reports whether this build has the emoji-test.txt data lite builds leave out
+func HasUnicodeData() bool {
+	return UnicodeVersion != ""
+}
+
+// HasVersionData reports whether this build has the version data SupportedIn needs
+func HasVersionData() bool {
+	return HasUnicodeData() || len(GemojiVersions) > 0
+}
+
+// SupportedIn reports whether an emoji is available in the given Unicode version
+//
+// Emoji without version data are assumed to be supported.
+func SupportedIn(emoji, version string) bool {
+	required, ok := UnicodeVersionOf(emoji)
+	if !ok {
+		return true
+	}
+
+	return CompareVersions(required, version) <= 0
+}
+
+// LatestVersion returns the highest emoji version used by the given entries
+func LatestVersion(entries []UnicodeEntry) string {
+	latest := ""
+	for _, entry := range entries {
+		if latest == "" || CompareVersions(entry.Version, latest) > 0 {
+			latest = entry.Version
+		}
+	}
+
+	return latest
+}
+
+// ParseVersion validates an emoji version such as "13.0" or "13", returning it as major.minor
+func ParseVersion(version string) (string, error) {
+	major, minor, hasMinor := strings.Cut(version, ".")
+	if !hasMinor {
+		minor = "0"
+	}
+
+	for _, part := range []string{major, minor} {
+		if part == "" || len(part) > 3 || strings.Trim(part, "0123456789") != "" {
+			return "", fmt.Errorf("invalid Unicode version %q (expected major.minor, such as 13.0)", version)
+		}
+	}
+
+	majorNum, _ := strconv.Atoi(major)
+	minorNum, _ := strconv.Atoi(minor)
+
+	return fmt.Sprintf("%d.%d", majorNum, minorNum), nil
+}
+
+// CompareVersions compares two dotted version numbers such as "13.0" and "5.0"
+//
+// It returns -1, 0 or 1 when a is lower than, equal to or higher than b.
+// Missing or malformed components count as zero.
+func CompareVersions(a, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+
+	for i := 0; i < max(len(partsA), len(partsB)); i++ {
+		var numA, numB int
+		if i < len(partsA) {
+			numA, _ = strconv.Atoi(partsA[i])
+		}
+		if i < len(partsB) {
+			numB, _ = strconv.Atoi(partsB[i])
+		}
+
+		switch {
+		case numA < numB:
+			return -1
+		case numA > numB:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// GenerateUnicodeGoCode generates Go code for the Unicode emoji entries
+func GenerateUnicodeGoCode(entries []UnicodeEntry, version string) string {
+	var builder strings.Builder
+
+	statusNames := map[string]string{
+		StatusComponent:          "StatusComponent",
+		StatusFullyQualified:     "StatusFullyQualified",
+		StatusMinimallyQualified: "StatusMinimallyQualified",
+		StatusUnqualified:        "StatusUnqualified",
+	}
+
+	builder.WriteString("// Code generated by emojify-scraper unicode; DO NOT EDIT.\n\n")
+	builder.WriteString("//go:build !emojify_lite\n\n")
+	builder.WriteString("package emoji\n\n")
+	builder.WriteString("// UnicodeVersion is the version of emoji-test.txt UnicodeEntries was generated from\n")
+	builder.WriteString(fmt.Sprintf("const UnicodeVersion = %q\n\n", version))
+	builder.WriteString("// UnicodeEntries holds every sequence listed in Unicode's emoji-test.txt, in CLDR order\n")
+	builder.WriteString("var UnicodeEntries = []UnicodeEntry{\n")
+
+	for _, entry := range entries {
+		builder.WriteString(fmt.Sprintf(
+			"\t{Emoji: %q, Alias: %q, Name: %q, Status: %s, Group: %q, Subgroup: %q, Version: %q},\n",
+			entry.Emoji, entry.Alias, entry.Name, statusNames[entry.Status],
+			entry.Group, entry.Subgroup, entry.Version,
+		))
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+var (
+	unicodeOnce sync.Once
+
+	// unicodeAliasMap maps CLDR-derived aliases to recommended emoji
+	unicodeAliasMap map[string]string
+
+	// unicodeByEmoji indexes UnicodeEntries by their exact emoji sequence
+	unicodeByEmoji map[string]int
+
+	// unicodeByBase indexes recommended entries by their sequence without variation selectors
+	unicodeByBase map[string]int
+
+	// variationBases holds the characters that recommended emoji follow with VS16
+	variationBases map[rune]bool
+)
+
+// buildUnicodeIndex creates the lookup tables for UnicodeEntries
+func buildUnicodeIndex() {
+	unicodeOnce.Do(func() {
+		unicodeAliasMap = make(map[string]string, len(UnicodeEntries))
+		unicodeByEmoji = make(map[string]int, len(UnicodeEntries))
+		unicodeByBase = make(map[string]int, len(UnicodeEntries))
+		variationBases = make(map[rune]bool)
+
+		for i, entry := range UnicodeEntries {
+			unicodeByEmoji[entry.Emoji] = i
+
+			if !entry.IsRGI() {
+				continue
+			}
+
+			unicodeByBase[StripVariationSelectors(entry.Emoji)] = i
+
+			runes := []rune(entry.Emoji)
+			for j := 1; j < len(runes); j++ {
+				if runes[j] == EmojiVariationSelector {
+					variationBases[runes[j-1]] = true
+				}
+			}
+
+			if _, exists := EmojiMap[entry.Alias]; !exists && entry.Alias != "" {
+				unicodeAliasMap[entry.Alias] = entry.Emoji
+			}
+		}
+	})
+}
+
+// LookupUnicode returns the emoji-test.txt entry for an emoji
+//
+// Sequences that differ from a recommended emoji only by variation selectors
+// resolve to the recommended entry.
+func LookupUnicode(emoji string) (UnicodeEntry, bool) {
+	buildUnicodeIndex()
+
+	if i, exists := unicodeByEmoji[emoji]; exists {
+		return UnicodeEntries[i], true
+	}
+
+	if i, exists := unicodeByBase[StripVariationSelectors(emoji)]; exists {
+		return UnicodeEntries[i], true
+	}
+
+	return UnicodeEntry{}, false
+}
+
+// UnicodeAliases returns the CLDR-derived aliases not already present in EmojiMap, sorted
+func UnicodeAliases() []string {
+	buildUnicodeIndex()
+
+	aliases := make([]string, 0, len(unicodeAliasMap))
+	for alias := range unicodeAliasMap {
+		aliases = append(aliases, alias)
+	}
+
+	sort.Strings(aliases)
+
+	return aliases
+}
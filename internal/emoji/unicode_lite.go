@@ -0,0 +1,14 @@
+//go:build emojify_lite
+
+package emoji
+
+// Lite builds leave out the Unicode emoji-test.txt data to reduce binary size,
+// which matters most for WebAssembly. Only the GitHub alias database is
+// available: CLDR-derived aliases, emoji names, and skin tone and gender
+// composition are not, and Unicode version filtering relies on GemojiVersions.
+
+// UnicodeVersion is the version of emoji-test.txt UnicodeEntries was generated from
+const UnicodeVersion = ""
+
+// UnicodeEntries is empty in lite builds
+var UnicodeEntries []UnicodeEntry
@@ -0,0 +1,300 @@
+package emoji
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// UnicodeTestSuite defines the test suite for emoji-test.txt ingestion
+type UnicodeTestSuite struct {
+	suite.Suite
+}
+
+// TestParseEmojiTest tests parsing of the emoji-test.txt format
+func (suite *UnicodeTestSuite) TestParseEmojiTest() {
+	entries, err := LoadEmojiTestFile(filepath.Join("testdata", "emoji-test-sample.txt"))
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), entries, 12)
+
+	assert.Equal(suite.T(), UnicodeEntry{
+		Emoji:    "😀",
+		Name:     "grinning face",
+		Status:   StatusFullyQualified,
+		Group:    "Smileys & Emotion",
+		Subgroup: "face-smiling",
+		Version:  "1.0",
+	}, entries[0])
+
+	assert.Equal(suite.T(), "☺", entries[3].Emoji)
+	assert.Equal(suite.T(), StatusUnqualified, entries[3].Status)
+	assert.Equal(suite.T(), "face-affection", entries[3].Subgroup)
+
+	assert.Equal(suite.T(), "🕵️‍♀", entries[8].Emoji)
+	assert.Equal(suite.T(), StatusMinimallyQualified, entries[8].Status)
+	assert.Equal(suite.T(), "4.0", entries[8].Version)
+
+	assert.Equal(suite.T(), StatusComponent, entries[9].Status)
+	assert.Equal(suite.T(), "Component", entries[9].Group)
+}
+
+// TestParseEmojiTestErrors tests that malformed lines are rejected
+func (suite *UnicodeTestSuite) TestParseEmojiTestErrors() {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "missing comment", input: "1F600 ; fully-qualified"},
+		{name: "missing status", input: "1F600 # 😀 E1.0 grinning face"},
+		{name: "bad code point", input: "1FZZZ ; fully-qualified # 😀 E1.0 grinning face"},
+		{name: "unknown status", input: "1F600 ; recommended # 😀 E1.0 grinning face"},
+		{name: "missing version", input: "1F600 ; fully-qualified # 😀 grinning"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := ParseEmojiTest(strings.NewReader(tt.input))
+			assert.Error(suite.T(), err)
+		})
+	}
+}
+
+// TestCLDRAlias tests alias derivation from CLDR short names
+func (suite *UnicodeTestSuite) TestCLDRAlias() {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "grinning face", expected: ":grinning_face:"},
+		{name: "waving hand: medium skin tone", expected: ":waving_hand_medium_skin_tone:"},
+		{name: "flag: Côte d’Ivoire", expected: ":flag_cote_divoire:"},
+		{name: "flag: São Tomé & Príncipe", expected: ":flag_sao_tome_and_principe:"},
+		{name: "keycap: #", expected: ":keycap_hash:"},
+		{name: "keycap: *", expected: ":keycap_asterisk:"},
+		{name: "man’s shoe", expected: ":mans_shoe:"},
+		{name: "A button (blood type)", expected: ":a_button_blood_type:"},
+		{name: "1st place medal", expected: ":1st_place_medal:"},
+		{name: "T-Rex", expected: ":t_rex:"},
+		{name: "ON! arrow", expected: ":on_arrow:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, CLDRAlias(tt.name))
+		})
+	}
+}
+
+// TestAssignAliases tests alias assignment and disambiguation
+func (suite *UnicodeTestSuite) TestAssignAliases() {
+	entries, err := LoadEmojiTestFile(filepath.Join("testdata", "emoji-test-sample.txt"))
+	require.NoError(suite.T(), err)
+
+	reserved := map[string]string{
+		// Same emoji under the same alias is not a conflict
+		":grinning_face:": "😀",
+		// A different emoji under the same alias is
+		":waving_hand:": "🖐️",
+	}
+
+	require.NoError(suite.T(), AssignAliases(entries, reserved))
+
+	assert.Equal(suite.T(), ":grinning_face:", entries[0].Alias)
+	assert.Equal(suite.T(), ":smiling_face:", entries[2].Alias)
+	assert.Equal(suite.T(), ":smiling_face:", entries[3].Alias, "Unqualified entries share the fully-qualified alias")
+	assert.Equal(suite.T(), ":waving_hand_2:", entries[4].Alias)
+	assert.Equal(suite.T(), ":waving_hand_medium_skin_tone:", entries[5].Alias)
+	assert.Equal(suite.T(), ":woman_detective:", entries[8].Alias)
+	assert.Equal(suite.T(), ":medium_skin_tone:", entries[9].Alias)
+	assert.Equal(suite.T(), ":flag_cote_divoire:", entries[10].Alias)
+	assert.Equal(suite.T(), ":keycap_hash:", entries[11].Alias)
+}
+
+// TestVerifyCoverage tests that missing and duplicate aliases are reported
+func (suite *UnicodeTestSuite) TestVerifyCoverage() {
+	missing := []UnicodeEntry{{Emoji: "😀", Name: "grinning face", Status: StatusFullyQualified}}
+	assert.ErrorContains(suite.T(), VerifyCoverage(missing), "U+1F600 (grinning face)")
+
+	duplicate := []UnicodeEntry{
+		{Emoji: "😀", Alias: ":face:", Status: StatusFullyQualified},
+		{Emoji: "😄", Alias: ":face:", Status: StatusFullyQualified},
+	}
+	assert.ErrorContains(suite.T(), VerifyCoverage(duplicate), "alias :face: is used by both")
+
+	unqualified := []UnicodeEntry{{Emoji: "☺", Status: StatusUnqualified}}
+	assert.NoError(suite.T(), VerifyCoverage(unqualified))
+}
+
+// TestCompareVersions tests dotted version comparison
+func (suite *UnicodeTestSuite) TestCompareVersions() {
+	assert.Equal(suite.T(), 0, CompareVersions("13.0", "13.0"))
+	assert.Equal(suite.T(), 0, CompareVersions("13", "13.0"))
+	assert.Equal(suite.T(), -1, CompareVersions("5.0", "13.0"))
+	assert.Equal(suite.T(), 1, CompareVersions("15.1", "15.0"))
+	assert.Equal(suite.T(), -1, CompareVersions("0.6", "0.7"))
+
+	for input, expected := range map[string]string{"13.0": "13.0", "13": "13.0", "0.6": "0.6", "015.1": "15.1"} {
+		version, err := ParseVersion(input)
+		require.NoError(suite.T(), err, "Version %q", input)
+		assert.Equal(suite.T(), expected, version)
+	}
+
+	for _, input := range []string{"", "NaN", "Inf", "1e3", "-1.0", "+13.0", "13.", ".5", "13.0.1", "13,0", " 13.0", "0x1.0"} {
+		_, err := ParseVersion(input)
+		assert.Error(suite.T(), err, "Version %q", input)
+	}
+
+	assert.Equal(suite.T(), "4.0", LatestVersion([]UnicodeEntry{{Version: "0.6"}, {Version: "4.0"}, {Version: "1.0"}}))
+}
+
+// TestUnicodeVersionOf tests the Unicode version required by emoji
+func (suite *UnicodeTestSuite) TestUnicodeVersionOf() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		emoji    string
+		expected string
+	}{
+		{name: "emoji 0.6 maps to unicode 6.0", emoji: "😄", expected: "6.0"},
+		{name: "emoji 1.0 maps to unicode 8.0", emoji: "😀", expected: "8.0"},
+		{name: "emoji 5.0 maps to unicode 10.0", emoji: "🤩", expected: "10.0"},
+		{name: "newer versions are unchanged", emoji: "🫠", expected: "14.0"},
+		{name: "emoji 13.1 maps to unicode 13.0", emoji: "❤️\u200d🔥", expected: "13.0"},
+		{name: "unqualified form", emoji: "✈", expected: "6.0"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			version, ok := UnicodeVersionOf(tt.emoji)
+			require.True(suite.T(), ok)
+			assert.Equal(suite.T(), tt.expected, version)
+		})
+	}
+
+	_, ok := UnicodeVersionOf("not an emoji")
+	assert.False(suite.T(), ok)
+
+	assert.True(suite.T(), SupportedIn("😄", "13.0"))
+	assert.True(suite.T(), SupportedIn("🫠", "14.0"))
+	assert.False(suite.T(), SupportedIn("🫠", "13.0"))
+	assert.True(suite.T(), SupportedIn("not an emoji", "6.0"), "Unknown emoji are assumed supported")
+}
+
+// TestGemojiVersionFallback tests versions for emoji that emoji-test.txt doesn't list
+func (suite *UnicodeTestSuite) TestGemojiVersionFallback() {
+	saved := GemojiVersions
+	defer func() { GemojiVersions = saved }()
+
+	// Emoji 16.0 is newer than the compiled emoji-test.txt data
+	GemojiVersions = map[string]string{"🫩": "16.0", "🪉": "16.0", "🫟": "16.0"}
+	assert.True(suite.T(), HasVersionData())
+
+	version, ok := UnicodeVersionOf("🫩")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), "16.0", version)
+	assert.False(suite.T(), SupportedIn("🫩", "15.1"))
+	assert.True(suite.T(), SupportedIn("🫩", "16.0"))
+
+	assert.False(suite.T(), SupportedIn("🫩\ufe0f", "15.1"), "Versions are found without variation selectors")
+}
+
+// TestEveryRGIEmojiHasAlias tests that the alias database covers every recommended emoji
+func (suite *UnicodeTestSuite) TestEveryRGIEmojiHasAlias() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	require.NotEmpty(suite.T(), UnicodeEntries)
+	require.NoError(suite.T(), VerifyCoverage(UnicodeEntries))
+
+	for _, entry := range UnicodeEntries {
+		if !entry.IsRGI() {
+			continue
+		}
+
+		alias := GetAlias(entry.Emoji)
+		assert.NotEqual(suite.T(), entry.Emoji, alias, "%s (%s) should have an alias", entry.Emoji, entry.Name)
+		assert.Equal(suite.T(), entry.Emoji, GetEmoji(alias), "%s should encode back to %s", alias, entry.Name)
+	}
+}
+
+// TestUnicodeAliasesDoNotShadowEmojiMap tests that gemoji aliases take precedence
+func (suite *UnicodeTestSuite) TestUnicodeAliasesDoNotShadowEmojiMap() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	for _, alias := range UnicodeAliases() {
+		_, exists := EmojiMap[alias]
+		assert.False(suite.T(), exists, "%s is already in EmojiMap", alias)
+
+		middle := alias[1 : len(alias)-1]
+		for _, char := range middle {
+			assert.True(suite.T(), IsValidEmojiChar(char), "Invalid char in alias %s: %c", alias, char)
+		}
+	}
+
+	assert.Equal(suite.T(), "🫶🏽", GetEmoji(":heart_hands_medium_skin_tone:"))
+	assert.Equal(suite.T(), ":heart_hands_medium_skin_tone:", GetAlias("🫶🏽"))
+	assert.Equal(suite.T(), "🐶", GetEmoji(":dog:"))
+}
+
+// TestLookupUnicode tests lookups that ignore variation selectors
+func (suite *UnicodeTestSuite) TestLookupUnicode() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	entry, ok := LookupUnicode("😀")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), "grinning face", entry.Name)
+	assert.Equal(suite.T(), "Smileys & Emotion", entry.Group)
+
+	entry, ok = LookupUnicode("✈")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), StatusUnqualified, entry.Status)
+
+	entry, ok = LookupUnicode("✈︎")
+	require.True(suite.T(), ok, "Text presentation should resolve to the recommended entry")
+	assert.Equal(suite.T(), "✈️", entry.Emoji)
+
+	_, ok = LookupUnicode("abc")
+	assert.False(suite.T(), ok)
+}
+
+// TestMatchEmoji tests longest-match emoji scanning
+func (suite *UnicodeTestSuite) TestMatchEmoji() {
+	if !HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	emoji, alias, ok := MatchEmoji("🫶🏽 hello")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), "🫶🏽", emoji)
+	assert.Equal(suite.T(), ":heart_hands_medium_skin_tone:", alias)
+
+	emoji, _, ok = MatchEmoji("👨‍👩‍👧 family")
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), "👨‍👩‍👧", emoji)
+
+	_, _, ok = MatchEmoji("hello 😄")
+	assert.False(suite.T(), ok)
+
+	_, _, ok = MatchEmoji("")
+	assert.False(suite.T(), ok)
+
+	assert.True(suite.T(), HasEmojiCharacters("text 😄"))
+	assert.False(suite.T(), HasEmojiCharacters("plain text"))
+}
+
+// TestUnicode runs all Unicode data tests
+func TestUnicode(t *testing.T) {
+	suite.Run(t, new(UnicodeTestSuite))
+}
@@ -0,0 +1,98 @@
+package emoji
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// Width returns the number of terminal columns an emoji occupies
+//
+// Emoji shown in emoji presentation are two columns wide: characters whose
+// default presentation is emoji, anything followed by VS16, and ZWJ, skin
+// tone, keycap and flag sequences. Characters that default to text
+// presentation, such as "✈" or "©", and anything followed by VS15 take the
+// width of their base character, usually one column.
+func Width(emoji string) int {
+	first, _ := utf8.DecodeRuneInString(emoji)
+	if runeWidth(first) == 0 {
+		// A stray selector or joiner has no base character to display
+		return 0
+	}
+
+	hasText, hasEmoji := false, false
+
+	for _, char := range emoji {
+		switch {
+		case char == TextVariationSelector:
+			hasText = true
+		case char == EmojiVariationSelector, char == ZeroWidthJoiner, char == CombiningKeycap,
+			skinToneOf(char) != SkinToneNone, isRegionalIndicator(char):
+			hasEmoji = true
+		}
+	}
+
+	if hasText && !hasEmoji {
+		return runeWidth(first)
+	}
+
+	if hasEmoji {
+		return 2
+	}
+
+	total := 0
+	for _, char := range emoji {
+		total += runeWidth(char)
+	}
+
+	return total
+}
+
+// DisplayWidth returns the number of terminal columns text occupies
+//
+// Known emoji are measured with Width. Other characters are two columns wide
+// if their East Asian Width is wide or fullwidth, zero for combining marks,
+// format and control characters, and one otherwise. Tabs count as one column.
+func DisplayWidth(text string) int {
+	total := 0
+
+	for i := 0; i < len(text); {
+		if emoji, _, ok := MatchEmoji(text[i:]); ok && emoji != "" {
+			total += Width(emoji)
+			i += len(emoji)
+			continue
+		}
+
+		char, size := utf8.DecodeRuneInString(text[i:])
+		total += runeWidth(char)
+		i += size
+	}
+
+	return total
+}
+
+// runeWidth returns the number of columns a single character occupies
+func runeWidth(char rune) int {
+	switch {
+	case char == '\t':
+		return 1
+	case unicode.In(char, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc):
+		return 0
+	case isRegionalIndicator(char):
+		// A lone regional indicator is usually drawn as a letter in a box
+		return 1
+	}
+
+	switch width.LookupRune(char).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// isRegionalIndicator reports whether char is a regional indicator symbol, used in pairs for flags
+func isRegionalIndicator(char rune) bool {
+	return char >= 0x1F1E6 && char <= 0x1F1FF
+}
@@ -0,0 +1,91 @@
+package emoji
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// WidthTestSuite defines the test suite for display widths
+type WidthTestSuite struct {
+	suite.Suite
+}
+
+// TestWidth tests the width of individual emoji
+func (suite *WidthTestSuite) TestWidth() {
+	tests := []struct {
+		name     string
+		emoji    string
+		expected int
+	}{
+		{"emoji presentation", "🚀", 2},
+		{"text presentation by default", "✈", 1},
+		{"VS16", "✈️", 2},
+		{"VS15", "❤︎", 1},
+		{"skin tone", "👋🏽", 2},
+		{"ZWJ sequence", "👩‍💻", 2},
+		{"family", "👨‍👩‍👧‍👦", 2},
+		{"flag", "🇯🇵", 2},
+		{"keycap", "1️⃣", 2},
+		{"tag sequence", "🏴󠁧󠁢󠁳󠁣󠁴󠁿", 2},
+		{"stray selector", "️", 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, Width(tt.emoji))
+		})
+	}
+}
+
+// TestUnicodeWidths tests that every fully-qualified emoji is two columns wide
+func (suite *WidthTestSuite) TestUnicodeWidths() {
+	for _, entry := range UnicodeEntries {
+		if entry.Status == StatusFullyQualified {
+			assert.Equal(suite.T(), 2, Width(entry.Emoji), "Emoji %s (%s)", entry.Emoji, entry.Name)
+		}
+	}
+}
+
+// TestEmojiMapWidths tests that every emoji in EmojiMap takes up space
+func (suite *WidthTestSuite) TestEmojiMapWidths() {
+	for alias, emoji := range EmojiMap {
+		// Some keycap entries are missing their base character
+		if strings.HasPrefix(emoji, string(EmojiVariationSelector)) {
+			continue
+		}
+
+		assert.Positive(suite.T(), Width(emoji), "Alias %s", alias)
+	}
+}
+
+// TestDisplayWidth tests the width of text mixing emoji and other characters
+func (suite *WidthTestSuite) TestDisplayWidth() {
+	tests := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{"empty", "", 0},
+		{"ASCII", "hello", 5},
+		{"alias", ":rocket:", 8},
+		{"emoji", "go 🚀!", 6},
+		{"sequences", "👩‍💻🇯🇵", 4},
+		{"CJK", "日本", 4},
+		{"combining marks", "é", 1},
+		{"text presentation", "✈ ok", 4},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, DisplayWidth(tt.text))
+		})
+	}
+}
+
+// TestWidths runs all display width tests
+func TestWidths(t *testing.T) {
+	suite.Run(t, new(WidthTestSuite))
+}
@@ -0,0 +1,72 @@
+package emojify
+
+import (
+	"strings"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// WithFixColumns keeps whitespace-aligned columns aligned after substitution
+//
+// Replacing an alias with an emoji changes the display width of its line,
+// which breaks tabular output such as "kubectl get" or "column -t". With this
+// option the width difference of the substitutions in each cell is added to
+// the next separator of two or more spaces on the same line, so later columns
+// stay where they were. A separator is never narrowed below one space.
+func WithFixColumns() Option {
+	return func(p *Processor) {
+		p.fixColumns = true
+	}
+}
+
+// processColumns is Process for WithFixColumns
+func (p *Processor) processColumns(text string, before rune) string {
+	var result strings.Builder
+	result.Grow(len(text))
+
+	delta := 0 // columns the rest of the line has to move right to stay aligned
+	p.scan(text, before, func(segment Segment) {
+		output := p.segmentOutput(segment)
+		if segment.Kind != SegmentText {
+			delta += emoji.DisplayWidth(segment.Source) - emoji.DisplayWidth(output)
+			result.WriteString(output)
+			return
+		}
+
+		delta = writeRepadded(&result, output, delta)
+	})
+
+	return result.String()
+}
+
+// writeRepadded writes text with its first column separator widened by delta, returning the delta still pending
+//
+// A newline ends the line, discarding any pending delta.
+func writeRepadded(b *strings.Builder, text string, delta int) int {
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == '\n':
+			delta = 0
+			b.WriteByte('\n')
+			i++
+		case text[i] == ' ' && delta != 0:
+			end := i
+			for end < len(text) && text[end] == ' ' {
+				end++
+			}
+
+			spaces := end - i
+			if spaces >= 2 {
+				spaces, delta = max(1, spaces+delta), 0
+			}
+
+			b.WriteString(strings.Repeat(" ", spaces))
+			i = end
+		default:
+			b.WriteByte(text[i])
+			i++
+		}
+	}
+
+	return delta
+}
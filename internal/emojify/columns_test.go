@@ -0,0 +1,111 @@
+package emojify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// ColumnsTestSuite defines the test suite for column-aligned output
+type ColumnsTestSuite struct {
+	suite.Suite
+}
+
+// TestFixColumns tests that separators are re-padded after substitution
+func (suite *ColumnsTestSuite) TestFixColumns() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "table",
+			input: "NAME     STATUS       AGE\n" +
+				"api      :rocket:     3d\n" +
+				"worker   :x: failed   1h\n",
+			expected: "NAME     STATUS       AGE\n" +
+				"api      🚀           3d\n" +
+				"worker   ❌ failed    1h\n",
+		},
+		{
+			name:     "several emoji in a cell",
+			input:    ":bug::bug:  next",
+			expected: "🐛🐛        next",
+		},
+		{
+			name:     "single spaces inside a cell are kept",
+			input:    "a :tada: b   c",
+			expected: "a 🎉 b       c",
+		},
+		{
+			name:     "last column",
+			input:    "a   :tada:\nb   c",
+			expected: "a   🎉\nb   c",
+		},
+		{
+			name:     "text presentation",
+			input:    ":airplane:  x",
+			expected: "✈️          x",
+		},
+	}
+
+	processor := NewProcessor(WithFixColumns())
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestNarrowing tests that separators are narrowed when the output is wider, but never removed
+func (suite *ColumnsTestSuite) TestNarrowing() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithFixColumns(), WithCommitTypes(map[string]string{"feat": ":sparkles:"}))
+	assert.Equal(suite.T(), "✨ feat:  x\nfix:     y", processor.Process("feat:     x\nfix:     y"))
+
+	processor = NewProcessor(WithFixColumns(), WithUnsupportedFallback("[unsupported emoji]"), WithMaxUnicodeVersion("13.0"))
+	assert.Equal(suite.T(), "[unsupported emoji] next", processor.Process(":melting_face:  next"))
+}
+
+// TestAlignment tests that every column starts at the same display column after fixing
+func (suite *ColumnsTestSuite) TestAlignment() {
+	input := "ID   ICON                 NAME\n1    :rocket:             launch\n2    :wave::skin-tone-4:  wave\n3    plain                text\n"
+
+	output := NewProcessor(WithFixColumns()).Process(input)
+
+	// The last column starts after the last space of each line
+	var positions []int
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		positions = append(positions, emoji.DisplayWidth(line[:strings.LastIndex(line, " ")+1]))
+	}
+
+	for _, position := range positions {
+		assert.Equal(suite.T(), positions[0], position, "Output:\n%s", output)
+	}
+}
+
+// TestStream tests that padding is corrected when a chunk ends between an emoji and its separator
+func (suite *ColumnsTestSuite) TestStream() {
+	processor := NewProcessor(WithFixColumns())
+	text := "NAME     STATUS       AGE\nworker   :x: failed   1h\napi      :rocket:     3d\n"
+
+	for _, chunks := range [][]string{
+		{"NAME     STATUS       AGE\nworker   :x: ", "failed   1h\napi      :rocket:", "     3d\n"},
+		strings.Split(text, ""),
+	} {
+		assert.Equal(suite.T(), processor.Process(text), convertChunks(processor.ProcessStream(), chunks))
+	}
+}
+
+// TestColumns runs all column-aligned output tests
+func TestColumns(t *testing.T) {
+	suite.Run(t, new(ColumnsTestSuite))
+}
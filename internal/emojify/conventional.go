@@ -0,0 +1,36 @@
+package emojify
+
+import (
+	"regexp"
+	"strings"
+)
+
+// conventionalCommit matches the start of a conventional commit subject such as "feat(api)!: add search"
+//
+// The abbreviated or full hash printed by "git log --oneline" may come first.
+// Group 1 is the hash and group 2 the commit type.
+var conventionalCommit = regexp.MustCompile(`^((?:[0-9a-f]{7,40} )?)([A-Za-z]+)(?:\([^()\r\n]*\))?!?: `)
+
+// commitPrefix returns where to insert a gitmoji before the conventional commit at the start of line, and the text to insert
+func (p *Processor) commitPrefix(line string) (int, string, bool) {
+	match := conventionalCommit.FindStringSubmatchIndex(line)
+	if match == nil {
+		return 0, "", false
+	}
+
+	value := p.commitTypes[strings.ToLower(line[match[4]:match[5]])]
+	if value == "" {
+		return 0, "", false
+	}
+
+	if strings.HasPrefix(value, ":") {
+		encoded, ok := p.encodeAlias(value)
+		if !ok {
+			return 0, "", false
+		}
+
+		value = encoded
+	}
+
+	return match[3], value + " ", true
+}
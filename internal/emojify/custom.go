@@ -0,0 +1,74 @@
+package emojify
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// DiscordEmojiURL is the image URL of Discord custom emoji, where the first
+// verb is the emoji ID and the second the file extension
+const DiscordEmojiURL = "https://cdn.discordapp.com/emojis/%s.%s"
+
+// discordEmoji matches a Discord custom emoji such as <:partyparrot:123456789012345678>
+//
+// Group 1 is "a" for animated emoji, group 2 the name and group 3 the ID.
+var discordEmoji = regexp.MustCompile(`^<(a?):([A-Za-z0-9_]{2,32}):([0-9]{15,21})>`)
+
+// WithCustomEmoji registers custom emoji, such as Slack's :partyparrot:, by alias and image URL
+//
+// Custom aliases are left unchanged in text output, and rendered as images
+// with WithHTML or WithMarkdown. Aliases of standard emoji take precedence.
+// Keys may be written with or without the surrounding colons.
+func WithCustomEmoji(images map[string]string) Option {
+	return func(p *Processor) {
+		p.customEmoji = make(map[string]string, len(images))
+		for alias, url := range images {
+			p.customEmoji[":"+strings.Trim(alias, ":")+":"] = url
+		}
+	}
+}
+
+// WithMarkdown makes Process render custom emoji as Markdown images
+//
+// Standard emoji are written as usual, since Markdown renderers display them
+// as text.
+func WithMarkdown() Option {
+	return func(p *Processor) {
+		p.markdown = true
+	}
+}
+
+// matchDiscordEmoji parses a Discord custom emoji at the start of text
+//
+// The image is the URL registered for its name, or Discord's own image URL.
+func (p *Processor) matchDiscordEmoji(text string) (token, alias, image string, ok bool) {
+	match := discordEmoji.FindStringSubmatch(text)
+	if match == nil {
+		return "", "", "", false
+	}
+
+	alias = ":" + match[2] + ":"
+	if url, exists := p.customEmoji[alias]; exists {
+		return match[0], alias, url, true
+	}
+
+	extension := "png"
+	if match[1] == "a" {
+		extension = "gif"
+	}
+
+	return match[0], alias, fmt.Sprintf(DiscordEmojiURL, match[3], extension), true
+}
+
+// markdownImage returns a Markdown image for a custom emoji
+func markdownImage(alias, url string) string {
+	escapeURL := strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E")
+	return "![" + alias + "](" + escapeURL.Replace(url) + ")"
+}
+
+// writeHTMLImage writes the <img> element for a custom emoji
+func (p *Processor) writeHTMLImage(b *strings.Builder, alias, url string) {
+	fmt.Fprintf(b, `<img class="%s" alt="%s" src="%s">`, html.EscapeString(p.html.Class), html.EscapeString(alias), html.EscapeString(url))
+}
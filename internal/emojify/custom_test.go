@@ -0,0 +1,110 @@
+package emojify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// CustomEmojiTestSuite defines the test suite for custom and Discord emoji
+type CustomEmojiTestSuite struct {
+	suite.Suite
+	images map[string]string
+}
+
+// SetupTest registers custom emoji for each test
+func (suite *CustomEmojiTestSuite) SetupTest() {
+	suite.images = map[string]string{
+		"partyparrot":  "https://example.com/party parrot.gif",
+		":blobcat:":    "/emoji/blobcat.png",
+		":rocket:":     "/emoji/rocket.png",
+		":blob_dance:": "/emoji/blob_dance.gif",
+	}
+}
+
+// TestProcess tests that custom emoji are left intact in text output
+func (suite *CustomEmojiTestSuite) TestProcess() {
+	processor := NewProcessor(WithCustomEmoji(suite.images))
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"custom alias", "Ship it :partyparrot: :tada:", "Ship it :partyparrot: 🎉"},
+		{"standard aliases take precedence", ":rocket:", "🚀"},
+		{"discord", "<:rocket:123456789012345678> :rocket:", "<:rocket:123456789012345678> 🚀"},
+		{"animated discord", "<a:dance:123456789012345678>", "<a:dance:123456789012345678>"},
+		{"not discord", "<:rocket:12> <:rocket:>", "<🚀12> <🚀>"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+
+	segments := processor.Segments("a :blobcat:")
+	assert.Equal(suite.T(), Segment{Kind: SegmentCustom, Source: ":blobcat:", Text: ":blobcat:", Offset: 2, Alias: ":blobcat:", Image: "/emoji/blobcat.png"}, segments[1])
+	assert.False(suite.T(), segments[1].IsUnknownAlias())
+
+	assert.Equal(suite.T(), "<:blobcat:123456789012345678>", NewProcessor().Process("<:blobcat:123456789012345678>"), "Discord emoji are recognized without a registry")
+}
+
+// TestMarkdown tests that custom emoji are rendered as Markdown images
+func (suite *CustomEmojiTestSuite) TestMarkdown() {
+	processor := NewProcessor(WithCustomEmoji(suite.images), WithMarkdown())
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"custom alias", "Yay :blobcat: :tada:", "Yay ![:blobcat:](/emoji/blobcat.png) 🎉"},
+		{"URL is escaped", ":partyparrot:", "![:partyparrot:](https://example.com/party%20parrot.gif)"},
+		{"discord uses the registry", "<a:blob_dance:123456789012345678>", "![:blob_dance:](/emoji/blob_dance.gif)"},
+		{"discord CDN", "<:other:123456789012345678>", "![:other:](https://cdn.discordapp.com/emojis/123456789012345678.png)"},
+		{"animated discord CDN", "<a:other:123456789012345678>", "![:other:](https://cdn.discordapp.com/emojis/123456789012345678.gif)"},
+		{"unknown alias", ":nope:", ":nope:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestHTML tests that custom emoji are rendered as images in HTML output
+func (suite *CustomEmojiTestSuite) TestHTML() {
+	processor := NewProcessor(WithCustomEmoji(suite.images), WithHTML(HTMLOptions{Tag: HTMLSpan}))
+	assert.Equal(suite.T(),
+		`<img class="emoji" alt=":blobcat:" src="/emoji/blobcat.png"> &amp; <img class="emoji" alt=":ab:" src="https://cdn.discordapp.com/emojis/123456789012345678.png">`,
+		processor.Process(":blobcat: & <:ab:123456789012345678>"))
+}
+
+// TestDecode tests that Discord emoji are decoded to their alias
+func (suite *CustomEmojiTestSuite) TestDecode() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"static", "nice <:blobcat:123456789012345678>", "nice :blobcat:"},
+		{"animated", "<a:party_parrot:123456789012345678>!", ":party_parrot:!"},
+		{"with emoji", "🚀<:blobcat:123456789012345678>", ":rocket::blobcat:"},
+		{"not discord", "a < b <:x> <:x:1>", "a < b <:x> <:x:1>"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, NewProcessor().Decode(tt.input))
+		})
+	}
+}
+
+// TestCustomEmoji runs all custom emoji tests
+func TestCustomEmoji(t *testing.T) {
+	suite.Run(t, new(CustomEmojiTestSuite))
+}
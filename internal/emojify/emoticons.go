@@ -0,0 +1,102 @@
+package emojify
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// WithEmoticons replaces classic emoticons such as :) and <3 with emoji, and makes Decode write those emoji back as emoticons
+//
+// The map is keyed by emoticon, and each value is an alias or emoji; an empty
+// value disables that emoticon. See emoji.DefaultEmoticons for the standard
+// mapping. Emoticons are only recognized as whole words: preceded by
+// whitespace or the start of the text, and followed by whitespace or the end
+// of the text, optionally after . , ! or ?, so URLs and code such as a:) are
+// left alone. When several emoticons decode to the same emoji, the one
+// chosen by emoji.PreferEmoticon is written.
+func WithEmoticons(emoticons map[string]string) Option {
+	return func(p *Processor) {
+		p.emoticons = make(map[string]string, len(emoticons))
+		p.emoticonsByEmoji = make(map[string]string, len(emoticons))
+		p.maxEmoticonLength = 0
+
+		for text, value := range emoticons {
+			if text == "" || value == "" {
+				continue
+			}
+
+			p.emoticons[text] = value
+			p.maxEmoticonLength = max(p.maxEmoticonLength, len(text))
+
+			if strings.HasPrefix(value, ":") {
+				value = emoji.GetEmoji(value)
+			}
+
+			key := emoji.StripVariationSelectors(value)
+			if current, exists := p.emoticonsByEmoji[key]; !exists || emoji.PreferEmoticon(text, current) {
+				p.emoticonsByEmoji[key] = text
+			}
+		}
+	}
+}
+
+// matchEmoticon returns the longest emoticon at the start of text that ends at a word boundary, and its replacement
+func (p *Processor) matchEmoticon(text string) (emoticon, emojiChar, alias string, ok bool) {
+	for end := min(len(text), p.maxEmoticonLength); end > 0; end-- {
+		value, exists := p.emoticons[text[:end]]
+		if !exists || !isEmoticonEnd(text[end:]) {
+			continue
+		}
+
+		alias = value
+		if strings.HasPrefix(value, ":") {
+			if emojiChar, ok = p.encodeAlias(value); !ok {
+				continue
+			}
+		} else {
+			emojiChar, alias = value, emoji.GetAlias(value)
+		}
+
+		return text[:end], emojiChar, alias, true
+	}
+
+	return "", "", "", false
+}
+
+// isEmoticonStart reports whether an emoticon may start at offset i of text, which follows the character before
+func isEmoticonStart(text string, i int, before rune) bool {
+	if i > 0 {
+		before, _ = utf8.DecodeLastRuneInString(text[:i])
+	}
+
+	return before == 0 || unicode.IsSpace(before)
+}
+
+// isEmoticonEnd reports whether an emoticon may end just before rest
+func isEmoticonEnd(rest string) bool {
+	// Trailing punctuation is allowed where the sentence goes on or ends
+	rest = strings.TrimLeft(rest, ".,!?")
+
+	after, _ := utf8.DecodeRuneInString(rest)
+	return rest == "" || unicode.IsSpace(after)
+}
+
+// decodedEmoticon returns the emoticon to write for an emoji being decoded, if it has one and stands alone
+//
+// The emoticon is only written where it would be recognized again by
+// Process, so decoding and encoding round-trip.
+func (p *Processor) decodedEmoticon(emojiChar string, written *strings.Builder, before rune, rest string) (string, bool) {
+	emoticon, exists := p.emoticonsByEmoji[emoji.StripVariationSelectors(emojiChar)]
+	if !exists {
+		return "", false
+	}
+
+	if !isEmoticonStart(written.String(), written.Len(), before) || !isEmoticonEnd(rest) {
+		return "", false
+	}
+
+	return emoticon, true
+}
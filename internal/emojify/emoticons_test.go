@@ -0,0 +1,119 @@
+package emojify
+
+import (
+	"testing"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// EmoticonsTestSuite defines the test suite for emoticon conversion
+type EmoticonsTestSuite struct {
+	suite.Suite
+	processor *Processor
+}
+
+// SetupTest creates a processor with the default emoticons for each test
+func (suite *EmoticonsTestSuite) SetupTest() {
+	suite.processor = NewProcessor(WithEmoticons(emoji.DefaultEmoticons()))
+}
+
+// TestProcess tests that emoticons are replaced only as whole words
+func (suite *EmoticonsTestSuite) TestProcess() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"smile", "Thanks :)", "Thanks 🙂"},
+		{"several", ":-D <3 ;) :'(", "😃 ❤️ 😉 😢"},
+		{"longest match", ">:( :(", "😠 🙁"},
+		{"trailing punctuation", "Done :)! Really :P.", "Done 🙂! Really 😛."},
+		{"start of a line", "line\n:)", "line\n🙂"},
+		{"with aliases", ":rocket: :) :tada:", "🚀 🙂 🎉"},
+		{"inside a word", "a:) x<3 :)b", "a:) x<3 :)b"},
+		{"urls", "http://example.com C:/ (n)th", "http://example.com C:/ (n)th"},
+		{"followed by letters", ":Party :pancakes", ":Party :pancakes"},
+		{"without emoticons in the text", "plain text", "plain text"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, suite.processor.Process(tt.input))
+		})
+	}
+
+	assert.Equal(suite.T(), "Thanks :)", NewProcessor().Process("Thanks :)"), "Emoticons are opt-in")
+}
+
+// TestDecode tests that emoji with an emoticon are decoded to it where it stands alone
+func (suite *EmoticonsTestSuite) TestDecode() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"smile", "Thanks 🙂", "Thanks :)"},
+		{"shortest emoticon", "😃 😛 😆 😎", ":D :P XD 8-)"},
+		{"variation selectors", "❤️ ❤", "<3 <3"},
+		{"punctuation", "Done 🙂!", "Done :)!"},
+		{"inside a word", "a🙂 🙂b", "a:slight_smile: :slight_smile:b"},
+		{"no emoticon", "🚀 😊", ":rocket: :blush:"},
+		{"skin tone", "👍🏽 👍", ":thumbsup_tone3: (y)"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, suite.processor.Decode(tt.input))
+		})
+	}
+}
+
+// TestRoundTrip tests that decoding and encoding again gives back the original text
+func (suite *EmoticonsTestSuite) TestRoundTrip() {
+	for _, input := range []string{"Thanks 🙂! See you 😉", "🙂🙂 and a🙂", "❤️ 🚀 <3"} {
+		assert.Equal(suite.T(), suite.processor.Process(input), suite.processor.Process(suite.processor.Decode(input)), "Input %q", input)
+	}
+}
+
+// TestCustomTable tests emoticons added, overridden and disabled by the caller
+func (suite *EmoticonsTestSuite) TestCustomTable() {
+	table := emoji.DefaultEmoticons()
+	table["\\o/"] = ":raised_hands:"
+	table["<3"] = "💜"
+	table[":P"] = ""
+
+	processor := NewProcessor(WithEmoticons(table))
+	assert.Equal(suite.T(), "🙌 💜 :P 🙂", processor.Process("\\o/ <3 :P :)"))
+	assert.Equal(suite.T(), "\\o/ <3 :p", processor.Decode("🙌 💜 😛"))
+}
+
+// TestOptions tests emoticons combined with other processor options
+func (suite *EmoticonsTestSuite) TestOptions() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithEmoticons(emoji.DefaultEmoticons()), WithSkinTone(emoji.SkinToneMedium))
+	assert.Equal(suite.T(), "👍🏽", processor.Process("(y)"))
+
+	processor = NewProcessor(WithEmoticons(emoji.DefaultEmoticons()), WithFallback(FallbackText))
+	assert.Equal(suite.T(), "[slightly_smiling_face]", processor.Process(":)"))
+
+	processor = NewProcessor(WithEmoticons(emoji.DefaultEmoticons()), WithMaxUnicodeVersion("7.0"))
+	assert.Equal(suite.T(), ":) 😉", processor.Process(":) ;)"), "Emoticons for unsupported emoji are left as written")
+
+	segments := suite.processor.Segments("ok :)")
+	assert.Equal(suite.T(), Segment{Kind: SegmentEmoji, Source: ":)", Text: "🙂", Offset: 3, Alias: ":slightly_smiling_face:"}, segments[1])
+}
+
+// TestEmoticons runs all emoticon tests
+func TestEmoticons(t *testing.T) {
+	suite.Run(t, new(EmoticonsTestSuite))
+}
@@ -0,0 +1,382 @@
+package emojify
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// Escape selects the escape sequences encoded emoji are written as, for ASCII-only output
+type Escape int
+
+const (
+	// EscapeNone writes emoji as UTF-8
+	EscapeNone Escape = iota
+	// EscapeJSON writes JSON and JavaScript escapes, with surrogate pairs: \ud83d\ude80
+	EscapeJSON
+	// EscapeGo writes Go string escapes: \U0001F680
+	EscapeGo
+	// EscapePython writes Python string escapes: \U0001F680
+	EscapePython
+	// EscapeHTML writes hexadecimal numeric character references: &#x1F680;
+	EscapeHTML
+	// EscapeCSS writes CSS escapes, each terminated by a space: \1F680
+	EscapeCSS
+)
+
+// anyEscape is the set of escapes recognized by WithDecodeEscapes: numeric
+// character references, JSON and JavaScript escapes, and \U0001F680
+const anyEscape Escape = -1
+
+// String returns the name used for the escape on the command line
+func (e Escape) String() string {
+	switch e {
+	case EscapeJSON:
+		return "json"
+	case EscapeGo:
+		return "go"
+	case EscapePython:
+		return "python"
+	case EscapeHTML:
+		return "html"
+	case EscapeCSS:
+		return "css"
+	default:
+		return "none"
+	}
+}
+
+// ParseEscape converts an escape name into an Escape
+func ParseEscape(name string) (Escape, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return EscapeNone, nil
+	case "json", "js", "javascript":
+		return EscapeJSON, nil
+	case "go":
+		return EscapeGo, nil
+	case "python", "py":
+		return EscapePython, nil
+	case "html":
+		return EscapeHTML, nil
+	case "css":
+		return EscapeCSS, nil
+	default:
+		return EscapeNone, fmt.Errorf("unknown escape %q (expected json, go, python, html or css)", name)
+	}
+}
+
+// WithEscape writes encoded emoji as escape sequences, and makes Decode recognize emoji written that way
+//
+// Emoji already in the text are escaped too, so the output has no raw emoji.
+// Only the non-ASCII characters of each emoji are escaped, so the rest of the
+// text, the space after a conventional commit gitmoji and an ASCII
+// unsupported fallback are written as-is.
+func WithEscape(escape Escape) Option {
+	return func(p *Processor) {
+		p.escape = escape
+	}
+}
+
+// WithDecodeEscapes makes Decode recognize emoji written as escapes
+//
+// Decimal and hexadecimal numeric character references (&#128640;, &#x1F680;),
+// JSON and JavaScript escapes including surrogate pairs (\ud83d\ude80,
+// \u{1F680}) and \U0001F680 are replaced with the alias of the emoji they
+// spell. Escapes that don't spell an emoji, and the text around them, are
+// left as written. An escape style set with WithEscape takes precedence.
+func WithDecodeEscapes() Option {
+	return func(p *Processor) {
+		p.decodeEscapes = true
+	}
+}
+
+// escapeEmoji escapes the emoji in text, leaving other characters as written
+func escapeEmoji(text string, escape Escape) string {
+	var result strings.Builder
+	result.Grow(len(text))
+
+	for i := 0; i < len(text); {
+		if text[i] < utf8.RuneSelf {
+			result.WriteByte(text[i])
+			i++
+			continue
+		}
+
+		if emojiChar, _, ok := emoji.MatchEmoji(text[i:]); ok {
+			result.WriteString(escapeString(emojiChar, escape))
+			i += len(emojiChar)
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(text[i:])
+		result.WriteString(text[i : i+size])
+		i += size
+	}
+
+	return result.String()
+}
+
+// escapeString writes the non-ASCII characters of s as escape sequences
+func escapeString(s string, escape Escape) string {
+	var result strings.Builder
+
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			result.WriteRune(r)
+			continue
+		}
+
+		switch escape {
+		case EscapeJSON:
+			if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+				fmt.Fprintf(&result, `\u%04x\u%04x`, r1, r2)
+			} else {
+				fmt.Fprintf(&result, `\u%04x`, r)
+			}
+		case EscapeGo, EscapePython:
+			if r > 0xFFFF {
+				fmt.Fprintf(&result, `\U%08X`, r)
+			} else {
+				fmt.Fprintf(&result, `\u%04X`, r)
+			}
+		case EscapeHTML:
+			fmt.Fprintf(&result, "&#x%X;", r)
+		case EscapeCSS:
+			fmt.Fprintf(&result, `\%X `, r)
+		default:
+			result.WriteRune(r)
+		}
+	}
+
+	return result.String()
+}
+
+// escapedRune is a character decoded from an escape sequence, and the sequence's end in the source
+type escapedRune struct {
+	char rune
+	end  int
+}
+
+// decodeEscapes replaces the runs of escape sequences in text that spell emoji with their aliases
+//
+// Escapes that don't form an emoji, and doubled backslashes, are copied as
+// written. The text between escapes is passed through decodeText, with the
+// character written before it; before is the character preceding text.
+func decodeEscapes(text string, before rune, escape Escape, decodeText func(string, rune) string) string {
+	var result strings.Builder
+	result.Grow(len(text))
+
+	start := 0 // start of text not yet written
+	for i := 0; i < len(text); {
+		if escape != EscapeHTML && strings.HasPrefix(text[i:], `\\`) {
+			i += 2
+			continue
+		}
+
+		var run []escapedRune
+		for end := i; ; {
+			char, length := parseEscape(text[end:], escape)
+			if length == 0 {
+				break
+			}
+
+			end += length
+			run = append(run, escapedRune{char: char, end: end})
+		}
+
+		if len(run) == 0 {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+			continue
+		}
+
+		result.WriteString(decodeText(text[start:i], lastRune(result.String(), before)))
+		writeEscapedRun(&result, text, i, run)
+		i = run[len(run)-1].end
+		start = i
+	}
+
+	result.WriteString(decodeText(text[start:], lastRune(result.String(), before)))
+	return result.String()
+}
+
+// lastRune returns the last character of text, or before if text is empty
+func lastRune(text string, before rune) rune {
+	if text == "" {
+		return before
+	}
+
+	char, _ := utf8.DecodeLastRuneInString(text)
+	return char
+}
+
+// writeEscapedRun writes a run of escaped characters starting at offset, replacing the emoji it spells with aliases
+func writeEscapedRun(b *strings.Builder, text string, offset int, run []escapedRune) {
+	var chars strings.Builder
+	ends := make([]int, len(run)) // end of each character in chars
+	for k, r := range run {
+		chars.WriteRune(r.char)
+		ends[k] = chars.Len()
+	}
+
+	decoded := chars.String()
+	source := offset // start of the escapes not yet written
+	for k, pos := 0, 0; k < len(run); {
+		emojiChar, alias, ok := emoji.MatchEmoji(decoded[pos:])
+		if !ok {
+			b.WriteString(text[source:run[k].end])
+			source, pos = run[k].end, ends[k]
+			k++
+			continue
+		}
+
+		b.WriteString(alias)
+		pos += len(emojiChar)
+		for k < len(run) && ends[k] <= pos {
+			k++
+		}
+
+		source = run[k-1].end
+	}
+}
+
+// parseEscape parses one escape sequence at the start of text, returning its character and length
+//
+// A length of 0 means text doesn't start with an escape of the given kind.
+func parseEscape(text string, escape Escape) (rune, int) {
+	switch escape {
+	case anyEscape:
+		for _, e := range []Escape{EscapeHTML, EscapeJSON, EscapeGo} {
+			if r, length := parseEscape(text, e); length > 0 {
+				return r, length
+			}
+		}
+	case EscapeJSON:
+		if r, length := parseBraceEscape(text); length > 0 {
+			return r, length
+		}
+
+		r, length := parseHexEscape(text, `\u`, 4)
+		if length == 0 || !utf16.IsSurrogate(r) {
+			return r, length
+		}
+
+		low, lowLength := parseHexEscape(text[length:], `\u`, 4)
+		if combined := utf16.DecodeRune(r, low); lowLength > 0 && combined != utf8.RuneError {
+			return combined, length + lowLength
+		}
+	case EscapeGo, EscapePython:
+		r, length := parseHexEscape(text, `\U`, 8)
+		if length == 0 {
+			r, length = parseHexEscape(text, `\u`, 4)
+		}
+
+		if length > 0 && !utf16.IsSurrogate(r) {
+			return r, length
+		}
+	case EscapeHTML:
+		return parseCharacterReference(text)
+	case EscapeCSS:
+		return parseCSSEscape(text)
+	}
+
+	return 0, 0
+}
+
+// parseHexEscape parses prefix followed by exactly digits hexadecimal digits
+func parseHexEscape(text, prefix string, digits int) (rune, int) {
+	length := len(prefix) + digits
+	if len(text) < length || !strings.HasPrefix(text, prefix) {
+		return 0, 0
+	}
+
+	value, err := strconv.ParseUint(text[len(prefix):length], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(value)) && !utf16.IsSurrogate(rune(value)) {
+		return 0, 0
+	}
+
+	return rune(value), length
+}
+
+// parseBraceEscape parses a JavaScript code point escape such as \u{1F680}
+func parseBraceEscape(text string) (rune, int) {
+	if !strings.HasPrefix(text, `\u{`) {
+		return 0, 0
+	}
+
+	end := strings.IndexByte(text, '}')
+	if end < 4 || end > 9 {
+		return 0, 0
+	}
+
+	value, err := strconv.ParseUint(text[3:end], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(value)) {
+		return 0, 0
+	}
+
+	return rune(value), end + 1
+}
+
+// parseCharacterReference parses a decimal or hexadecimal numeric character reference such as &#x1F680;
+func parseCharacterReference(text string) (rune, int) {
+	if !strings.HasPrefix(text, "&#") {
+		return 0, 0
+	}
+
+	digits, base := text[2:], 10
+	if strings.HasPrefix(digits, "x") || strings.HasPrefix(digits, "X") {
+		digits, base = digits[1:], 16
+	}
+
+	end := strings.IndexByte(digits, ';')
+	if end <= 0 || end > 8 {
+		return 0, 0
+	}
+
+	value, err := strconv.ParseUint(digits[:end], base, 32)
+	if err != nil || !utf8.ValidRune(rune(value)) {
+		return 0, 0
+	}
+
+	return rune(value), len(text) - len(digits) + end + 1
+}
+
+// parseCSSEscape parses a CSS escape of up to six hexadecimal digits and an optional terminating whitespace
+func parseCSSEscape(text string) (rune, int) {
+	if !strings.HasPrefix(text, `\`) {
+		return 0, 0
+	}
+
+	end := 1
+	for end < len(text) && end <= 6 && isHexDigit(text[end]) {
+		end++
+	}
+
+	if end == 1 {
+		return 0, 0
+	}
+
+	value, err := strconv.ParseUint(text[1:end], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(value)) {
+		return 0, 0
+	}
+
+	switch {
+	case strings.HasPrefix(text[end:], "\r\n"):
+		end += 2
+	case end < len(text) && strings.IndexByte(" \t\n\r\f", text[end]) >= 0:
+		end++
+	}
+
+	return rune(value), end
+}
+
+// isHexDigit reports whether c is a hexadecimal digit
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -0,0 +1,153 @@
+package emojify
+
+import (
+	"testing"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// EscapeTestSuite defines the test suite for escaped emoji output
+type EscapeTestSuite struct {
+	suite.Suite
+}
+
+// TestParseEscape tests parsing of escape names
+func (suite *EscapeTestSuite) TestParseEscape() {
+	for name, expected := range map[string]Escape{"": EscapeNone, "json": EscapeJSON, "JS": EscapeJSON, "go": EscapeGo, "python": EscapePython, "html": EscapeHTML, "css": EscapeCSS} {
+		escape, err := ParseEscape(name)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, escape, "Name %q", name)
+	}
+
+	_, err := ParseEscape("yaml")
+	assert.Error(suite.T(), err)
+}
+
+// TestProcess tests writing encoded emoji as escape sequences
+func (suite *EscapeTestSuite) TestProcess() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		escape   Escape
+		input    string
+		expected string
+	}{
+		{"json surrogate pairs", EscapeJSON, "Ship :rocket:!", `Ship \ud83d\ude80!`},
+		{"json BMP characters", EscapeJSON, ":heart:", `\u2764\ufe0f`},
+		{"go", EscapeGo, ":rocket: :heart:", `\U0001F680 \u2764\uFE0F`},
+		{"python", EscapePython, ":rocket:", `\U0001F680`},
+		{"html", EscapeHTML, ":wave::skin-tone-4:", "&#x1F44B;&#x1F3FD;"},
+		{"css", EscapeCSS, ":rocket:a", `\1F680 a`},
+		{"other text is unchanged", EscapeJSON, "café :nope: ✓", "café :nope: ✓"},
+		{"emoji in the text", EscapeJSON, "👍🏽 🚀 :tada:", `\ud83d\udc4d\ud83c\udffd \ud83d\ude80 \ud83c\udf89`},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithEscape(tt.escape))
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestProcessASCIIText tests that text inserted by other options stays unescaped
+func (suite *EscapeTestSuite) TestProcessASCIIText() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithEscape(EscapeJSON), WithCommitTypes(emoji.DefaultCommitTypes()))
+	assert.Equal(suite.T(), `\u2728 feat: add \ud83d\ude80`, processor.Process("feat: add :rocket:"))
+
+	processor = NewProcessor(WithEscape(EscapeGo), WithMaxUnicodeVersion("13.0"), WithUnsupportedFallback("?"))
+	assert.Equal(suite.T(), "melting ?", processor.Process("melting :melting_face:"))
+}
+
+// TestDecode tests that emoji written as escapes are decoded, and other escapes are kept
+func (suite *EscapeTestSuite) TestDecode() {
+	tests := []struct {
+		name     string
+		escape   Escape
+		input    string
+		expected string
+	}{
+		{"json", EscapeJSON, `Ship \ud83d\ude80 \u2764\ufe0f`, "Ship :rocket: :heart:"},
+		{"json keeps other escapes", EscapeJSON, `caf\u00e9\n\u00e9\ud83d\ude80`, `caf\u00e9\n\u00e9:rocket:`},
+		{"javascript code points", EscapeJSON, `\u{1F680}\u{2764}\u{fe0f} \u{110000}`, `:rocket::heart: \u{110000}`},
+		{"json lone surrogate", EscapeJSON, `\ud83d x \ude80`, `\ud83d x \ude80`},
+		{"escaped backslash", EscapeJSON, `\\ud83d\ude80`, `\\ud83d\ude80`},
+		{"raw emoji", EscapeJSON, `🚀 \ud83d\ude80`, ":rocket: :rocket:"},
+		{"go", EscapeGo, `\U0001F680\u2764\ufe0f`, ":rocket::heart:"},
+		{"go rejects surrogates", EscapeGo, `\ud83d\ude80`, `\ud83d\ude80`},
+		{"python", EscapePython, `x\U0001f680`, "x:rocket:"},
+		{"html hexadecimal and decimal", EscapeHTML, "&#x1F680; &#128640; &#xe9;", ":rocket: :rocket: &#xe9;"},
+		{"css", EscapeCSS, `\1F680 a \1f680`, ":rocket:a :rocket:"},
+		{"partial sequence", EscapeHTML, "&#x1F44B;&#x1F3FD;&#x1F680;", ":wave_tone3::rocket:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithEscape(tt.escape))
+			assert.Equal(suite.T(), tt.expected, processor.Decode(tt.input))
+		})
+	}
+}
+
+// TestDecodeEscapes tests recognizing every supported escape form when decoding
+func (suite *EscapeTestSuite) TestDecodeEscapes() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"decimal reference", "Deployed &#128640; today", "Deployed :rocket: today"},
+		{"hexadecimal reference", "&#x1F680;&#X1f680;", ":rocket::rocket:"},
+		{"code point escape", `\u{1F680}`, ":rocket:"},
+		{"surrogate pair", `\ud83d\ude80`, ":rocket:"},
+		{"long escape", `\U0001F680`, ":rocket:"},
+		{"mixed forms in one emoji", `&#x2764;\ufe0f`, ":heart:"},
+		{"skin tone sequence", "&#x1F44B;&#x1F3FD;", ":wave_tone3:"},
+		{"raw emoji", "🚀", ":rocket:"},
+		{"surrounding text is not unescaped", `&amp; &#233; \u00e9 \n &#128640;`, `&amp; &#233; \u00e9 \n :rocket:`},
+		{"escaped backslash", `\\u{1F680}`, `\\u{1F680}`},
+		{"incomplete sequences", `&#128640 \ud83d \u{1F680`, `&#128640 \ud83d \u{1F680`},
+	}
+
+	processor := NewProcessor(WithDecodeEscapes())
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, processor.Decode(tt.input))
+		})
+	}
+
+	// Without the option escapes are left alone
+	assert.Equal(suite.T(), "&#128640;", NewProcessor().Decode("&#128640;"))
+
+	// An explicit escape style takes precedence
+	assert.Equal(suite.T(), "&#128640; :rocket:", NewProcessor(WithDecodeEscapes(), WithEscape(EscapeCSS)).Decode(`&#128640; \1F680`))
+}
+
+// TestRoundTrip tests that decoding escaped output gives back the aliases
+func (suite *EscapeTestSuite) TestRoundTrip() {
+	input := "Deploy :rocket: :technologist_dark_skin_tone: :family_man_woman_girl_boy: :heart:"
+
+	for _, escape := range []Escape{EscapeJSON, EscapeGo, EscapePython, EscapeHTML, EscapeCSS} {
+		suite.Run(escape.String(), func() {
+			processor := NewProcessor(WithEscape(escape))
+			assert.Equal(suite.T(), input, processor.Decode(processor.Process(input)))
+		})
+	}
+}
+
+// TestEscape runs all escaped output tests
+func TestEscape(t *testing.T) {
+	suite.Run(t, new(EscapeTestSuite))
+}
@@ -0,0 +1,158 @@
+package emojify
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// Fallback selects the plain text written in place of emoji, for environments that can't display them
+type Fallback int
+
+const (
+	// FallbackNone writes emoji
+	FallbackNone Fallback = iota
+	// FallbackASCII writes the classic emoticon for an emoji, such as :) for 🙂, or its alias in brackets
+	FallbackASCII
+	// FallbackText writes the alias of an emoji in brackets: [rocket]
+	FallbackText
+	// FallbackStrip removes emoji
+	FallbackStrip
+	// FallbackDescription writes the Unicode name of an emoji in parentheses: (rocket)
+	FallbackDescription
+)
+
+// String returns the name used for the fallback on the command line
+func (f Fallback) String() string {
+	switch f {
+	case FallbackASCII:
+		return "ascii"
+	case FallbackText:
+		return "text"
+	case FallbackStrip:
+		return "strip"
+	case FallbackDescription:
+		return "description"
+	default:
+		return "none"
+	}
+}
+
+// ParseFallback converts a fallback name into a Fallback
+func ParseFallback(name string) (Fallback, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return FallbackNone, nil
+	case "ascii":
+		return FallbackASCII, nil
+	case "text":
+		return FallbackText, nil
+	case "strip":
+		return FallbackStrip, nil
+	case "description":
+		return FallbackDescription, nil
+	default:
+		return FallbackNone, fmt.Errorf("unknown fallback %q (expected ascii, text, strip, description or none)", name)
+	}
+}
+
+// WithFallback makes Process write plain text instead of emoji
+//
+// Both aliases and emoji already present in the text are converted, so the
+// output contains no emoji at all. Emoji without an emoticon fall back to
+// their alias in FallbackASCII mode. HTML output is unaffected.
+func WithFallback(fallback Fallback) Option {
+	return func(p *Processor) {
+		p.fallback = fallback
+	}
+}
+
+// emitFallback emits a segment with its emoji replaced by fallback text
+//
+// Emoji in literal text are split into SegmentEmoji segments of their own, so
+// Segments still reports what changed.
+func (p *Processor) emitFallback(segment Segment, emit func(Segment)) {
+	switch {
+	case segment.Kind == SegmentEmoji && segment.Source == "":
+		// A gitmoji inserted before a conventional commit, followed by a space
+		content, _ := strings.CutSuffix(segment.Text, " ")
+		if segment.Text = p.replaceEmoji(content); segment.Text != "" {
+			segment.Text += " "
+		}
+
+		emit(segment)
+	case segment.Kind == SegmentEmoji:
+		segment.Text = p.fallbackText(segment.Text, segment.Alias)
+		emit(segment)
+	case segment.Kind == SegmentText:
+		p.splitEmoji(segment, emit)
+	default:
+		emit(segment)
+	}
+}
+
+// splitEmoji emits a text segment as text and SegmentEmoji segments for the emoji it contains
+func (p *Processor) splitEmoji(segment Segment, emit func(Segment)) {
+	text := segment.Source
+	start := 0 // start of text not yet emitted
+
+	for i := 0; i < len(text); {
+		emojiChar, alias, ok := emoji.MatchEmoji(text[i:])
+		if !ok {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+			continue
+		}
+
+		if i > start {
+			emit(Segment{Kind: SegmentText, Source: text[start:i], Text: text[start:i], Offset: segment.Offset + start})
+		}
+
+		emit(Segment{Kind: SegmentEmoji, Source: emojiChar, Text: p.fallbackText(emojiChar, alias), Offset: segment.Offset + i, Alias: alias})
+		i += len(emojiChar)
+		start = i
+	}
+
+	if start < len(text) {
+		emit(Segment{Kind: SegmentText, Source: text[start:], Text: text[start:], Offset: segment.Offset + start})
+	}
+}
+
+// replaceEmoji replaces every emoji in text with its fallback text
+func (p *Processor) replaceEmoji(text string) string {
+	var result strings.Builder
+
+	p.splitEmoji(Segment{Kind: SegmentText, Source: text}, func(segment Segment) {
+		result.WriteString(segment.Text)
+	})
+
+	return result.String()
+}
+
+// fallbackText returns the text written in place of an emoji
+//
+// The text of an unsupported emoji fallback is passed through unchanged.
+func (p *Processor) fallbackText(emojiChar, alias string) string {
+	if !emoji.HasEmojiCharacters(emojiChar) {
+		return emojiChar
+	}
+
+	if alias == "" {
+		alias = emoji.GetAlias(emojiChar)
+	}
+
+	switch p.fallback {
+	case FallbackASCII:
+		if emoticon, ok := emoji.LookupEmoticon(emojiChar); ok {
+			return emoticon
+		}
+	case FallbackStrip:
+		return ""
+	case FallbackDescription:
+		return "(" + describe(emojiChar, alias) + ")"
+	}
+
+	return "[" + strings.Trim(alias, ":") + "]"
+}
@@ -0,0 +1,102 @@
+package emojify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// FallbackTestSuite defines the test suite for plain text fallback output
+type FallbackTestSuite struct {
+	suite.Suite
+}
+
+// TestParseFallback tests parsing of fallback names
+func (suite *FallbackTestSuite) TestParseFallback() {
+	for name, expected := range map[string]Fallback{"": FallbackNone, "none": FallbackNone, "ascii": FallbackASCII, "TEXT": FallbackText, "strip": FallbackStrip, "description": FallbackDescription} {
+		fallback, err := ParseFallback(name)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, fallback, "Name %q", name)
+	}
+
+	_, err := ParseFallback("emoji")
+	assert.Error(suite.T(), err)
+}
+
+// TestProcess tests that aliases and emoji are both replaced with text
+func (suite *FallbackTestSuite) TestProcess() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		fallback Fallback
+		input    string
+		expected string
+	}{
+		{"text alias", FallbackText, "Ship :rocket:", "Ship [rocket]"},
+		{"text emoji", FallbackText, "Ship 🚀", "Ship [rocket]"},
+		{"text keeps the alias written", FallbackText, ":thumbsup: :+1:", "[thumbsup] [+1]"},
+		{"text skin tone alias", FallbackText, ":wave_tone3:", "[wave_tone3]"},
+		{"ascii emoticon", FallbackASCII, "Thanks :slightly_smiling_face: ❤️", "Thanks :) <3"},
+		{"ascii without emoticon", FallbackASCII, "🚀 :bug:", "[rocket] [bug]"},
+		{"strip", FallbackStrip, "Done🎉 :tada:!", "Done !"},
+		{"description", FallbackDescription, "🚀 :heart_eyes:", "(rocket) (smiling face with heart-eyes)"},
+		{"unknown aliases and plain text", FallbackText, "12:30:45 :unknown: café", "12:30:45 :unknown: café"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithFallback(tt.fallback))
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestSegments tests that emoji in literal text are reported as segments of their own
+func (suite *FallbackTestSuite) TestSegments() {
+	input := "a 🚀 :bug: b"
+	segments := NewProcessor(WithFallback(FallbackText)).Segments(input)
+
+	var source, text strings.Builder
+	for _, segment := range segments {
+		assert.Equal(suite.T(), segment.Source, input[segment.Offset:segment.Offset+len(segment.Source)])
+		source.WriteString(segment.Source)
+		text.WriteString(segment.Text)
+	}
+
+	assert.Equal(suite.T(), input, source.String())
+	assert.Equal(suite.T(), "a [rocket] [bug] b", text.String())
+	require.Len(suite.T(), segments, 5)
+	assert.Equal(suite.T(), Segment{Kind: SegmentEmoji, Source: "🚀", Text: "[rocket]", Offset: 2, Alias: ":rocket:"}, segments[1])
+}
+
+// TestCombinedOptions tests fallback text with other processor options
+func (suite *FallbackTestSuite) TestCombinedOptions() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithFallback(FallbackText), WithCommitTypes(map[string]string{"feat": ":sparkles:"}))
+	assert.Equal(suite.T(), "[sparkles] feat: add [rocket]", processor.Process("feat: add 🚀"))
+
+	processor = NewProcessor(WithFallback(FallbackStrip), WithCommitTypes(map[string]string{"feat": ":sparkles:"}))
+	assert.Equal(suite.T(), "feat: add ", processor.Process("feat: add :rocket:"))
+
+	processor = NewProcessor(WithFallback(FallbackText), WithFixColumns())
+	assert.Equal(suite.T(), "[rocket]  up\nother     down", processor.Process("🚀        up\nother     down"))
+
+	processor = NewProcessor(WithFallback(FallbackText), WithMaxUnicodeVersion("13.0"), WithUnsupportedFallback("?"))
+	assert.Equal(suite.T(), "? [rocket]", processor.Process(":melting_face: :rocket:"))
+}
+
+// TestFallback runs all fallback tests
+func TestFallback(t *testing.T) {
+	suite.Run(t, new(FallbackTestSuite))
+}
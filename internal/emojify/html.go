@@ -0,0 +1,149 @@
+package emojify
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// HTMLCodePlaceholder is replaced by the image name of an emoji in an HTML image URL template
+const HTMLCodePlaceholder = "{{code}}"
+
+const (
+	// DefaultHTMLClass is the class of emoji elements in HTML output
+	DefaultHTMLClass = "emoji"
+	// TwemojiURL is the default image URL template for twemoji naming
+	TwemojiURL = "https://cdn.jsdelivr.net/gh/jdecked/twemoji@latest/assets/72x72/" + HTMLCodePlaceholder + ".png"
+	// NotoURL is the default image URL template for Noto naming
+	NotoURL = "https://cdn.jsdelivr.net/gh/googlefonts/noto-emoji@main/png/72/" + HTMLCodePlaceholder + ".png"
+)
+
+// HTMLTag selects the element emoji are rendered as in HTML output
+type HTMLTag int
+
+const (
+	// HTMLImage renders emoji as <img> elements, for clients without emoji fonts
+	HTMLImage HTMLTag = iota
+	// HTMLSpan renders emoji as text in a <span> with an accessible description
+	HTMLSpan
+)
+
+// String returns the name used for the tag on the command line
+func (t HTMLTag) String() string {
+	if t == HTMLSpan {
+		return "span"
+	}
+
+	return "img"
+}
+
+// ParseHTMLTag converts a tag name into an HTMLTag
+func ParseHTMLTag(name string) (HTMLTag, error) {
+	switch strings.ToLower(name) {
+	case "", "img", "image":
+		return HTMLImage, nil
+	case "span":
+		return HTMLSpan, nil
+	default:
+		return HTMLImage, fmt.Errorf("unknown HTML tag %q (expected img or span)", name)
+	}
+}
+
+// HTMLOptions configures HTML output
+type HTMLOptions struct {
+	Tag HTMLTag
+	// URL is the image URL template, where HTMLCodePlaceholder is replaced by
+	// the image name; empty uses the default for Naming
+	URL string
+	// Class is the class of emoji elements; empty uses DefaultHTMLClass
+	Class  string
+	Naming emoji.ImageNaming
+	// TextIsHTML writes the text around emoji unescaped, for input that is already HTML
+	TextIsHTML bool
+}
+
+// WithHTML makes Process output HTML, rendering each alias as an element and escaping the surrounding text
+func WithHTML(opts HTMLOptions) Option {
+	return func(p *Processor) {
+		if opts.URL == "" {
+			opts.URL = TwemojiURL
+			if opts.Naming == emoji.NamingNoto {
+				opts.URL = NotoURL
+			}
+		}
+
+		if opts.Class == "" {
+			opts.Class = DefaultHTMLClass
+		}
+
+		p.html = &opts
+	}
+}
+
+// processHTML is Process for HTML output
+func (p *Processor) processHTML(text string, before rune) string {
+	var result strings.Builder
+	result.Grow(len(text))
+
+	escape := html.EscapeString
+	if p.html.TextIsHTML {
+		escape = func(s string) string { return s }
+	}
+
+	p.scan(text, before, func(segment Segment) {
+		if segment.Kind == SegmentCustom {
+			p.writeHTMLImage(&result, segment.Alias, segment.Image)
+			return
+		}
+
+		if segment.Kind != SegmentEmoji {
+			result.WriteString(escape(segment.Source))
+			return
+		}
+
+		// Gitmoji inserted before a conventional commit are followed by a space
+		content, space := strings.CutSuffix(segment.Text, " ")
+
+		// The unsupported fallback text isn't an emoji, and is never HTML
+		if !emoji.HasEmojiCharacters(content) {
+			result.WriteString(html.EscapeString(segment.Text))
+			return
+		}
+
+		alias := segment.Alias
+		if alias == "" {
+			alias = emoji.GetAlias(content)
+		}
+
+		p.writeHTMLEmoji(&result, content, alias)
+		if space {
+			result.WriteString(" ")
+		}
+	})
+
+	return result.String()
+}
+
+// writeHTMLEmoji writes the element for an emoji
+func (p *Processor) writeHTMLEmoji(b *strings.Builder, emojiChar, alias string) {
+	class := html.EscapeString(p.html.Class)
+
+	if p.html.Tag == HTMLSpan {
+		fmt.Fprintf(b, `<span class="%s" role="img" aria-label="%s">%s</span>`, class, html.EscapeString(describe(emojiChar, alias)), emojiChar)
+		return
+	}
+
+	src := strings.ReplaceAll(p.html.URL, HTMLCodePlaceholder, emoji.ImageName(emojiChar, p.html.Naming))
+	fmt.Fprintf(b, `<img class="%s" alt="%s" src="%s">`, class, html.EscapeString(emojiChar), html.EscapeString(src))
+}
+
+// describe returns a readable description of an emoji: its Unicode name, or its alias as words
+func describe(emojiChar, alias string) string {
+	if info, ok := emoji.Lookup(emojiChar); ok && info.Name != "" {
+		return info.Name
+	}
+
+	return strings.ReplaceAll(strings.Trim(alias, ":"), "_", " ")
+}
@@ -0,0 +1,114 @@
+package emojify
+
+import (
+	"testing"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// HTMLTestSuite defines the test suite for HTML output
+type HTMLTestSuite struct {
+	suite.Suite
+}
+
+// TestParseHTMLTag tests parsing of HTML tag names
+func (suite *HTMLTestSuite) TestParseHTMLTag() {
+	for name, expected := range map[string]HTMLTag{"": HTMLImage, "img": HTMLImage, "image": HTMLImage, "SPAN": HTMLSpan} {
+		tag, err := ParseHTMLTag(name)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, tag, "Name %q", name)
+	}
+
+	_, err := ParseHTMLTag("div")
+	assert.Error(suite.T(), err)
+}
+
+// TestProcessHTML tests rendering aliases as elements and escaping text
+func (suite *HTMLTestSuite) TestProcessHTML() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		opts     HTMLOptions
+		input    string
+		expected string
+	}{
+		{
+			name:     "image",
+			input:    "Hi :smile:",
+			expected: `Hi <img class="emoji" alt="😄" src="https://cdn.jsdelivr.net/gh/jdecked/twemoji@latest/assets/72x72/1f604.png">`,
+		},
+		{
+			name:     "text is escaped",
+			input:    `<b>"Tom" & :nope: 12:30</b>`,
+			expected: `&lt;b&gt;&#34;Tom&#34; &amp; :nope: 12:30&lt;/b&gt;`,
+		},
+		{
+			name:     "URL template and class",
+			opts:     HTMLOptions{URL: "/emoji/{{code}}.svg?a=1&b=2", Class: "icon"},
+			input:    ":wave::skin-tone-4:",
+			expected: `<img class="icon" alt="👋🏽" src="/emoji/1f44b-1f3fd.svg?a=1&amp;b=2">`,
+		},
+		{
+			name:     "noto naming",
+			opts:     HTMLOptions{Naming: emoji.NamingNoto},
+			input:    ":rocket:",
+			expected: `<img class="emoji" alt="🚀" src="https://cdn.jsdelivr.net/gh/googlefonts/noto-emoji@main/png/72/emoji_u1f680.png">`,
+		},
+		{
+			name:     "span",
+			opts:     HTMLOptions{Tag: HTMLSpan, Class: `a"b`},
+			input:    ":rocket: :+1:",
+			expected: `<span class="a&#34;b" role="img" aria-label="rocket">🚀</span> <span class="a&#34;b" role="img" aria-label="thumbs up">👍</span>`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithHTML(tt.opts))
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestProcessHTMLInput tests that text already in HTML isn't escaped again
+func (suite *HTMLTestSuite) TestProcessHTMLInput() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithHTML(HTMLOptions{Tag: HTMLSpan, TextIsHTML: true}), WithMaxUnicodeVersion("13.0"), WithUnsupportedFallback("<?>"))
+
+	assert.Equal(suite.T(),
+		`Tom &amp; Jerry <span class="emoji" role="img" aria-label="red heart">❤️</span> &lt;?&gt;`,
+		processor.Process("Tom &amp; Jerry :heart: :melting_face:"))
+}
+
+// TestProcessHTMLWithOptions tests HTML output combined with other processor options
+func (suite *HTMLTestSuite) TestProcessHTMLWithOptions() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(
+		WithHTML(HTMLOptions{Tag: HTMLSpan}),
+		WithCommitTypes(map[string]string{"feat": ":sparkles:"}),
+		WithMaxUnicodeVersion("13.0"),
+		WithUnsupportedFallback("<?>"),
+	)
+
+	assert.Equal(suite.T(),
+		`<span class="emoji" role="img" aria-label="sparkles">✨</span> feat: melt &lt;?&gt;`,
+		processor.Process("feat: melt :melting_face:"))
+}
+
+// TestHTML runs all HTML output tests
+func TestHTML(t *testing.T) {
+	suite.Run(t, new(HTMLTestSuite))
+}
@@ -0,0 +1,74 @@
+package emojify
+
+import (
+	"strings"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// Option configures optional Processor behaviour
+type Option func(*Processor)
+
+// WithMaxUnicodeVersion limits encoding to emoji available in the given Unicode version
+//
+// Aliases for newer emoji are left as text, or replaced with the text set by
+// WithUnsupportedFallback.
+func WithMaxUnicodeVersion(version string) Option {
+	return func(p *Processor) {
+		p.maxUnicodeVersion = version
+	}
+}
+
+// WithUnsupportedFallback sets the text used in place of emoji newer than the maximum Unicode version
+func WithUnsupportedFallback(text string) Option {
+	return func(p *Processor) {
+		p.unsupportedFallback = &text
+	}
+}
+
+// WithPresentation sets the variation selectors used for encoded emoji
+func WithPresentation(presentation emoji.Presentation) Option {
+	return func(p *Processor) {
+		p.presentation = presentation
+	}
+}
+
+// WithSkinTone sets the skin tone used for emoji that support modifiers
+//
+// Aliases that already name a skin tone, such as :wave_tone1:, are unaffected.
+func WithSkinTone(tone emoji.SkinTone) Option {
+	return func(p *Processor) {
+		p.skinTone = tone
+	}
+}
+
+// WithGender sets the gendered form used for emoji that have one
+func WithGender(gender emoji.Gender) Option {
+	return func(p *Processor) {
+		p.gender = gender
+	}
+}
+
+// WithLocale also encodes the localized aliases of a locale, such as :rakete: in German
+//
+// Standard aliases take precedence, and decoding is unaffected.
+func WithLocale(locale *emoji.Locale) Option {
+	return func(p *Processor) {
+		p.locale = locale
+	}
+}
+
+// WithCommitTypes prefixes conventional commit subjects such as "feat: add search" with a gitmoji
+//
+// The map is keyed by commit type, and each value is an alias or emoji; an
+// empty value leaves that type unchanged. Types are matched case-insensitively
+// at the start of each line, optionally after a "git log --oneline" hash.
+// See emoji.DefaultCommitTypes for the standard mapping.
+func WithCommitTypes(types map[string]string) Option {
+	return func(p *Processor) {
+		p.commitTypes = make(map[string]string, len(types))
+		for commitType, value := range types {
+			p.commitTypes[strings.ToLower(commitType)] = value
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package emojify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// OptionsTestSuite defines the test suite for Processor options
+type OptionsTestSuite struct {
+	suite.Suite
+}
+
+// TestMaxUnicodeVersion tests that newer emoji are left as aliases
+func (suite *OptionsTestSuite) TestMaxUnicodeVersion() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		version  string
+		input    string
+		expected string
+	}{
+		{
+			name:     "older emoji are encoded",
+			version:  "13.0",
+			input:    "Launch :rocket: :smile:",
+			expected: "Launch 🚀 😄",
+		},
+		{
+			name:     "newer emoji stay as aliases",
+			version:  "13.0",
+			input:    "Melting :melting_face: :rocket:",
+			expected: "Melting :melting_face: 🚀",
+		},
+		{
+			name:     "emoji at the limit are encoded",
+			version:  "14.0",
+			input:    "Melting :melting_face:",
+			expected: "Melting 🫠",
+		},
+		{
+			name:     "skin tone sequences use their own version",
+			version:  "7.0",
+			input:    ":wave: :wave_tone3:",
+			expected: "👋 :wave_tone3:",
+		},
+		{
+			name:     "unsupported alias is followed by another alias",
+			version:  "13.0",
+			input:    ":melting_face:rocket:",
+			expected: ":melting_face🚀",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithMaxUnicodeVersion(tt.version))
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestUnsupportedFallback tests the replacement text for newer emoji
+func (suite *OptionsTestSuite) TestUnsupportedFallback() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithMaxUnicodeVersion("13.0"), WithUnsupportedFallback("□"))
+	assert.Equal(suite.T(), "□ 🚀", processor.Process(":melting_face: :rocket:"))
+
+	processor = NewProcessor(WithMaxUnicodeVersion("13.0"), WithUnsupportedFallback(""))
+	assert.Equal(suite.T(), "Melting ", processor.Process("Melting :melting_face:"))
+
+	// Without a version limit the fallback is never used
+	processor = NewProcessor(WithUnsupportedFallback("□"))
+	assert.Equal(suite.T(), "🫠", processor.Process(":melting_face:"))
+}
+
+// TestMaxUnicodeVersionDoesNotAffectDecode tests that decoding ignores the version limit
+func (suite *OptionsTestSuite) TestMaxUnicodeVersionDoesNotAffectDecode() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithMaxUnicodeVersion("6.0"))
+	assert.Equal(suite.T(), ":melting_face:", processor.Decode("🫠"))
+}
+
+// TestPresentation tests the variation selectors used for encoded emoji
+func (suite *OptionsTestSuite) TestPresentation() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name         string
+		presentation emoji.Presentation
+		input        string
+		expected     string
+	}{
+		{name: "default", presentation: emoji.PresentationDefault, input: ":smile:", expected: "😄"},
+		{name: "emoji", presentation: emoji.PresentationEmoji, input: ":airplane:", expected: "✈️"},
+		{name: "text", presentation: emoji.PresentationText, input: ":airplane: :smile:", expected: "✈︎ 😄"},
+		{name: "strip", presentation: emoji.PresentationStrip, input: ":airplane:", expected: "✈"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithPresentation(tt.presentation))
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestPresentationRoundTrip tests that every presentation decodes back to the alias
+func (suite *OptionsTestSuite) TestPresentationRoundTrip() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	for _, presentation := range []emoji.Presentation{emoji.PresentationEmoji, emoji.PresentationText, emoji.PresentationStrip} {
+		processor := NewProcessor(WithPresentation(presentation))
+		encoded := processor.Process(":airplane: :heart:")
+		assert.Equal(suite.T(), ":airplane: :heart:", processor.Decode(encoded), "Presentation %s", presentation)
+	}
+}
+
+// TestSkinTone tests default and inline skin tones
+func (suite *OptionsTestSuite) TestSkinTone() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	tests := []struct {
+		name     string
+		tone     emoji.SkinTone
+		input    string
+		expected string
+	}{
+		{name: "default tone", tone: emoji.SkinToneMedium, input: ":wave: :thumbsup:", expected: "👋🏽 👍🏽"},
+		{name: "default tone skips unsupported emoji", tone: emoji.SkinToneMedium, input: ":smile: :rocket:", expected: "😄 🚀"},
+		{name: "toned alias wins over default", tone: emoji.SkinToneMedium, input: ":wave_tone1:", expected: "👋🏻"},
+		{name: "generic tone suffix", tone: emoji.SkinToneNone, input: ":technologist_tone5:", expected: "🧑🏿‍💻"},
+		{name: "inline modifier", tone: emoji.SkinToneNone, input: "Hi :wave::skin-tone-4:!", expected: "Hi 👋🏽!"},
+		{name: "inline modifier wins over default", tone: emoji.SkinToneDark, input: ":wave::skin-tone-2:", expected: "👋🏻"},
+		{name: "inline modifier on unsupported emoji", tone: emoji.SkinToneNone, input: ":smile::skin-tone-4:", expected: "😄:skin-tone-4:"},
+		{name: "invalid inline modifier", tone: emoji.SkinToneNone, input: ":wave::skin-tone-9:", expected: "👋:skin-tone-9:"},
+		{name: "tone suffix on unsupported emoji", tone: emoji.SkinToneNone, input: ":smile_tone3:", expected: ":smile_tone3:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			processor := NewProcessor(WithSkinTone(tt.tone))
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+}
+
+// TestGender tests default gendered forms
+func (suite *OptionsTestSuite) TestGender() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithGender(emoji.GenderFemale))
+	assert.Equal(suite.T(), "🏃‍♀️ 👩‍💻 🚀", processor.Process(":runner: :technologist: :rocket:"))
+
+	processor = NewProcessor(WithGender(emoji.GenderMale), WithSkinTone(emoji.SkinToneMediumDark))
+	assert.Equal(suite.T(), "🤷🏾‍♂️ 👩🏾‍💻", processor.Process(":shrug: :woman_technologist:"))
+}
+
+// TestLocale tests encoding localized aliases
+func (suite *OptionsTestSuite) TestLocale() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	locale := emoji.NewLocale("ja", []emoji.LocaleEntry{
+		{Emoji: "🚀", Alias: ":ロケット:", Name: "ロケット"},
+		{Emoji: "👍", Alias: ":サムズアップ:", Name: "サムズアップ"},
+		{Emoji: "🐛", Alias: ":rocket:", Name: "rocket"},
+	})
+
+	processor := NewProcessor(WithLocale(locale))
+	assert.Equal(suite.T(), "🚀 🚀 👍🏽", processor.Process(":ロケット: :rocket: :サムズアップ_tone3:"))
+	assert.Equal(suite.T(), "リリース🚀です", processor.Process("リリース:ロケット:です"))
+	assert.Equal(suite.T(), ":rocket:", processor.Decode("🚀"), "Decoding is unaffected")
+
+	processor = NewProcessor(WithLocale(locale), WithSkinTone(emoji.SkinToneDark))
+	assert.Equal(suite.T(), "👍🏿", processor.Process(":サムズアップ:"))
+
+	assert.Equal(suite.T(), ":ロケット:", NewProcessor().Process(":ロケット:"))
+}
+
+// TestSkinToneRespectsMaxUnicodeVersion tests that default tones are dropped for older Unicode versions
+func (suite *OptionsTestSuite) TestSkinToneRespectsMaxUnicodeVersion() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	// 🤝 is Unicode 9.0 but its skin tone variants were added in 14.0
+	processor := NewProcessor(WithSkinTone(emoji.SkinToneMedium), WithMaxUnicodeVersion("13.0"))
+	assert.Equal(suite.T(), "🤝 👋🏽", processor.Process(":handshake: :wave:"))
+
+	// Explicit tones are not dropped
+	assert.Equal(suite.T(), ":handshake_tone3:", processor.Process(":handshake_tone3:"))
+}
+
+// TestCommitTypes tests gitmoji prefixes for conventional commits
+func (suite *OptionsTestSuite) TestCommitTypes() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	processor := NewProcessor(WithCommitTypes(emoji.DefaultCommitTypes()))
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "type", input: "feat: add search", expected: "✨ feat: add search"},
+		{name: "scope and breaking change", input: "fix(api)!: handle :rocket: aliases", expected: "🐛 fix(api)!: handle 🚀 aliases"},
+		{name: "case insensitive", input: "Docs: update README", expected: "📝 Docs: update README"},
+		{name: "oneline log", input: "1a2b3c4 perf: faster scan\n9f8e7d6 chore: tidy\n", expected: "1a2b3c4 ⚡ perf: faster scan\n9f8e7d6 🔧 chore: tidy\n"},
+		{name: "unknown type", input: "wip: stuff", expected: "wip: stuff"},
+		{name: "already prefixed", input: ":sparkles: feat: add search", expected: "✨ feat: add search"},
+		{name: "not at line start", input: "see feat: add search", expected: "see feat: add search"},
+		{name: "no space after colon", input: "feat:add", expected: "feat:add"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, processor.Process(tt.input))
+		})
+	}
+
+	// Custom mappings accept emoji and aliases, and empty values disable a type
+	processor = NewProcessor(
+		WithCommitTypes(map[string]string{"feat": "🚀", "Fix": ":airplane:", "docs": ""}),
+		WithPresentation(emoji.PresentationText),
+	)
+	assert.Equal(suite.T(), "🚀 feat: a\n✈︎ fix: b\ndocs: c", processor.Process("feat: a\nfix: b\ndocs: c"))
+
+	assert.Equal(suite.T(), "feat: add search", NewProcessor().Process("feat: add search"), "Prefixes are opt-in")
+
+	// Segments keep the original source intact
+	var source strings.Builder
+	for _, segment := range NewProcessor(WithCommitTypes(emoji.DefaultCommitTypes())).Segments("feat: x") {
+		source.WriteString(segment.Source)
+	}
+
+	assert.Equal(suite.T(), "feat: x", source.String())
+}
+
+// TestOptions runs all option tests
+func TestOptions(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
@@ -3,87 +3,328 @@
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/damienbutt/emojify-go/internal/emoji"
 )
 
 // Processor handles emoji replacement in text
-type Processor struct{}
+type Processor struct {
+	maxUnicodeVersion   string
+	unsupportedFallback *string
+	presentation        emoji.Presentation
+	skinTone            emoji.SkinTone
+	gender              emoji.Gender
+	locale              *emoji.Locale
+	commitTypes         map[string]string
+	html                *HTMLOptions
+	escape              Escape
+	decodeEscapes       bool
+	fixColumns          bool
+	fallback            Fallback
+	emoticons           map[string]string
+	emoticonsByEmoji    map[string]string
+	maxEmoticonLength   int
+	customEmoji         map[string]string
+	markdown            bool
+}
 
 // NewProcessor creates a new emoji processor
-func NewProcessor() *Processor {
-	return &Processor{}
+func NewProcessor(opts ...Option) *Processor {
+	processor := &Processor{}
+	for _, opt := range opts {
+		opt(processor)
+	}
+
+	return processor
 }
 
 // Process replaces emoji aliases in the given text with actual emoji characters
+//
+// With WithHTML the result is HTML instead.
 func (p *Processor) Process(text string) string {
-	if !emoji.HasEmoji(text) {
+	return p.process(text, 0)
+}
+
+// process is Process for text that follows the character before, or 0 at the start of the text
+func (p *Processor) process(text string, before rune) string {
+	if p.html != nil {
+		return p.processHTML(text, before)
+	}
+
+	if !emoji.HasEmoji(text) && p.fallback == FallbackNone && p.emoticons == nil && p.escape == EscapeNone {
 		return text
 	}
 
+	if p.fixColumns {
+		return p.processColumns(text, before)
+	}
+
 	var result strings.Builder
-	var currentToken strings.Builder
-	inToken := false
+	result.Grow(len(text))
+
+	p.scan(text, before, func(segment Segment) {
+		result.WriteString(p.segmentOutput(segment))
+	})
 
-	runes := []rune(text)
+	return result.String()
+}
 
-	for i, char := range runes {
-		if !inToken {
+// segmentOutput returns the text Process writes for a segment
+func (p *Processor) segmentOutput(segment Segment) string {
+	if segment.Kind == SegmentEmoji && p.escape != EscapeNone {
+		return escapeString(segment.Text, p.escape)
+	}
+
+	if segment.Kind == SegmentText && p.escape != EscapeNone {
+		return escapeEmoji(segment.Text, p.escape)
+	}
+
+	if segment.Kind == SegmentCustom && p.markdown {
+		return markdownImage(segment.Alias, segment.Image)
+	}
+
+	return segment.Text
+}
+
+// Segments splits text into the pieces Process would output
+//
+// Concatenating the Text of every segment gives the result of Process, and
+// concatenating their Source gives the original text.
+func (p *Processor) Segments(text string) []Segment {
+	var segments []Segment
+
+	p.scan(text, 0, func(segment Segment) {
+		segments = append(segments, segment)
+	})
+
+	return segments
+}
+
+// scan tokenizes text into literal text and :alias: tokens, calling emit for each segment in order
+//
+// before is the character preceding text, or 0 at the start of the text,
+// which decides whether text starts a line or may start with an emoticon.
+func (p *Processor) scan(text string, before rune, emit func(Segment)) {
+	if p.fallback != FallbackNone && p.html == nil {
+		emitSegment := emit
+		emit = func(segment Segment) { p.emitFallback(segment, emitSegment) }
+	}
+
+	textStart := 0   // start of literal text not yet emitted
+	tokenStart := -1 // start of the current :token, or -1 outside a token
+	lineStart := 0   // start of a line not yet checked for a conventional commit, or -1
+	if before != 0 && before != '\n' {
+		lineStart = -1
+	}
+
+	emitText := func(end int) {
+		if end > textStart {
+			emit(Segment{Kind: SegmentText, Source: text[textStart:end], Text: text[textStart:end], Offset: textStart})
+		}
+	}
+
+	for i := 0; i < len(text); {
+		if i == lineStart && p.commitTypes != nil {
+			lineStart = -1
+
+			if at, prefix, ok := p.commitPrefix(text[i:]); ok {
+				at += i
+				emitText(at)
+				emit(Segment{Kind: SegmentEmoji, Text: prefix, Offset: at})
+				textStart, i = at, at
+
+				continue
+			}
+		}
+
+		if tokenStart < 0 && p.emoticons != nil && isEmoticonStart(text, i, before) {
+			if emoticon, emojiResult, alias, ok := p.matchEmoticon(text[i:]); ok {
+				emitText(i)
+				emit(Segment{Kind: SegmentEmoji, Source: emoticon, Text: emojiResult, Offset: i, Alias: alias})
+				textStart = i + len(emoticon)
+				i = textStart
+
+				continue
+			}
+		}
+
+		char, size := utf8.DecodeRuneInString(text[i:])
+		if char == '\n' {
+			lineStart = i + size
+		}
+
+		if char == '<' {
+			if token, alias, image, ok := p.matchDiscordEmoji(text[i:]); ok {
+				emitText(i)
+				emit(Segment{Kind: SegmentCustom, Source: token, Text: token, Offset: i, Alias: alias, Image: image})
+				textStart, tokenStart = i+len(token), -1
+				i = textStart
+
+				continue
+			}
+		}
+
+		switch {
+		case tokenStart < 0:
 			// Starting a new token
 			if char == ':' {
-				inToken = true
-				currentToken.WriteRune(char)
-			} else {
-				result.WriteRune(char)
+				tokenStart = i
 			}
-		} else {
-			// Inside a token
-			if char == ':' {
-				// Finishing the current token
-				currentToken.WriteRune(char)
-				token := currentToken.String()
-				emojiResult := emoji.GetEmoji(token)
-
-				// If no replacement was made, we might need to keep the leading ':'
-				// for potential future emoji matches
-				if emojiResult == token {
-					// Check if this could be the start of another emoji
-					if i+1 < len(runes) && emoji.IsValidEmojiChar(runes[i+1]) {
-						// Write everything except the last ':' and start a new token
-						result.WriteString(token[:len(token)-1])
-						currentToken.Reset()
-						currentToken.WriteRune(':')
-					} else {
-						// Not the start of another emoji, write everything
-						result.WriteString(token)
-						currentToken.Reset()
-						inToken = false
+
+			i += size
+		case char == ':':
+			// Finishing the current token
+			token := text[tokenStart : i+size]
+			end := i + size
+			emojiResult, replaced := p.encodeAlias(token)
+
+			// An inline modifier such as :wave::skin-tone-4: applies to the emoji before it
+			if replaced {
+				if tone, length := skinToneSuffix(text[end:]); length > 0 {
+					if toned, ok := p.encodeAliasWithTone(token, tone); ok {
+						emojiResult = toned
+						end += length
 					}
-				} else {
-					// Successful replacement
-					result.WriteString(emojiResult)
-					currentToken.Reset()
-					inToken = false
 				}
-			} else if emoji.IsValidEmojiChar(char) {
-				// Valid character for emoji alias
-				currentToken.WriteRune(char)
+
+				emitText(tokenStart)
+				emit(Segment{Kind: SegmentEmoji, Source: text[tokenStart:end], Text: emojiResult, Offset: tokenStart, Alias: token})
+				textStart, tokenStart, i = end, -1, end
+
+				continue
+			}
+
+			if image, ok := p.customEmoji[token]; ok {
+				emitText(tokenStart)
+				emit(Segment{Kind: SegmentCustom, Source: token, Text: token, Offset: tokenStart, Alias: token, Image: image})
+				textStart, tokenStart, i = end, -1, end
+
+				continue
+			}
+
+			// The closing ':' might also open the next alias, as in ":unknown:smile:"
+			next, _ := utf8.DecodeRuneInString(text[end:])
+			reopens := end < len(text) && emoji.IsValidEmojiChar(next)
+
+			if len(token) > 2 {
+				sourceEnd := end
+				if reopens {
+					sourceEnd = i
+				}
+
+				emitText(tokenStart)
+				emit(Segment{Kind: SegmentUnknown, Source: text[tokenStart:sourceEnd], Text: text[tokenStart:sourceEnd], Offset: tokenStart, Alias: token})
+				textStart = sourceEnd
+			}
+
+			if reopens {
+				tokenStart = i
 			} else {
-				// Invalid character, drop the current token
-				currentToken.WriteRune(char)
-				result.WriteString(currentToken.String())
-				currentToken.Reset()
-				inToken = false
+				tokenStart = -1
 			}
+
+			i = end
+		case emoji.IsValidEmojiChar(char):
+			// Valid character for emoji alias
+			i += size
+		default:
+			// Invalid character, drop the current token
+			tokenStart = -1
+			i += size
 		}
 	}
 
-	// Handle any remaining token
-	if currentToken.Len() > 0 {
-		result.WriteString(currentToken.String())
+	emitText(len(text))
+}
+
+// encodeAlias returns the replacement for a complete :alias: token
+func (p *Processor) encodeAlias(alias string) (string, bool) {
+	emojiChar, ok := p.lookupAlias(alias)
+	if !ok {
+		// Any alias that supports skin tones accepts a _toneN suffix
+		base, tone, ok := emoji.SplitToneAlias(alias)
+		if !ok {
+			return alias, false
+		}
+
+		return p.encodeAliasWithTone(base, tone)
 	}
 
-	return result.String()
+	return p.encodeEmoji(alias, emojiChar, p.skinTone, true)
+}
+
+// encodeAliasWithTone returns the replacement for an alias with an explicit skin tone
+func (p *Processor) encodeAliasWithTone(alias string, tone emoji.SkinTone) (string, bool) {
+	emojiChar, ok := p.lookupAlias(alias)
+	if !ok {
+		return alias, false
+	}
+
+	if !emoji.SupportsSkinTone(emojiChar) {
+		return alias, false
+	}
+
+	return p.encodeEmoji(alias, emojiChar, tone, false)
+}
+
+// lookupAlias returns the emoji for a standard alias, or a localized one with WithLocale
+func (p *Processor) lookupAlias(alias string) (string, bool) {
+	if emojiChar := emoji.GetEmoji(alias); emojiChar != alias {
+		return emojiChar, true
+	}
+
+	if p.locale != nil {
+		return p.locale.GetEmoji(alias)
+	}
+
+	return "", false
+}
+
+// encodeEmoji applies the processor's modifiers, version limit and presentation to an emoji
+//
+// Default modifiers are best effort: they are dropped when the modified emoji
+// is newer than the maximum Unicode version, while explicit ones are not.
+func (p *Processor) encodeEmoji(alias, emojiChar string, tone emoji.SkinTone, defaultTone bool) (string, bool) {
+	modified := emojiChar
+	if gendered, ok := emoji.ApplyGender(modified, p.gender); ok && p.isSupported(gendered) {
+		modified = gendered
+	}
+
+	if toned, ok := emoji.ApplySkinTone(modified, tone); ok && (!defaultTone || p.isSupported(toned)) {
+		modified = toned
+	}
+
+	if !p.isSupported(modified) {
+		if p.unsupportedFallback != nil {
+			return *p.unsupportedFallback, true
+		}
+
+		return alias, false
+	}
+
+	return emoji.ApplyPresentation(modified, p.presentation), true
+}
+
+// isSupported reports whether an emoji is within the maximum Unicode version
+func (p *Processor) isSupported(emojiChar string) bool {
+	return p.maxUnicodeVersion == "" || emoji.SupportedIn(emojiChar, p.maxUnicodeVersion)
+}
+
+// skinToneSuffix parses an inline :skin-tone-N: modifier at the start of text
+func skinToneSuffix(text string) (emoji.SkinTone, int) {
+	const length = len(":skin-tone-N:")
+
+	if len(text) < length {
+		return emoji.SkinToneNone, 0
+	}
+
+	tone, ok := emoji.ParseSkinToneAlias(text[:length])
+	if !ok {
+		return emoji.SkinToneNone, 0
+	}
+
+	return tone, length
 }
 
 // Process is a convenience function that creates a processor and processes text
@@ -93,41 +334,64 @@ func Process(text string) string {
 }
 
 // Decode replaces emoji characters in the given text with their aliases
+//
+// With WithEscape or WithDecodeEscapes, emoji written as escape sequences are
+// replaced too. With WithEmoticons, emoji that have an emoticon are written as
+// the emoticon instead. Discord custom emoji such as
+// <:blobcat:123456789012345678> are replaced with their alias, :blobcat:.
 func (p *Processor) Decode(text string) string {
-	// Quick check - if no multi-byte characters, likely no emoji
-	if len(text) == len([]rune(text)) {
-		return text
+	return p.decode(text, 0)
+}
+
+// decode is Decode for text that follows the character before, or 0 at the start of the text
+func (p *Processor) decode(text string, before rune) string {
+	if p.escape != EscapeNone {
+		return decodeEscapes(text, before, p.escape, p.decodeText)
 	}
 
-	result := text
-	// Get the reverse mapping and decode emojis
-	reverseMap := emoji.GetReverseMap()
+	if p.decodeEscapes {
+		return decodeEscapes(text, before, anyEscape, p.decodeText)
+	}
 
-	// We need to handle emoji characters which can be multi-byte
-	// Process the string by iterating through potential emoji matches
-	// Sort by length (longest first) to handle compound emoji correctly
-	var emojis []string
-	for emojiChar := range reverseMap {
-		emojis = append(emojis, emojiChar)
+	return p.decodeText(text, before)
+}
+
+// decodeText replaces the emoji characters in text, which follows the character before, with their aliases
+func (p *Processor) decodeText(text string, before rune) string {
+	// Quick check - if no multi-byte characters or Discord emoji, likely no emoji
+	if len(text) == len([]rune(text)) && !strings.Contains(text, "<") {
+		return text
 	}
 
-	// Sort by length descending to replace longer emoji first
-	for i := 0; i < len(emojis); i++ {
-		for j := i + 1; j < len(emojis); j++ {
-			if len(emojis[i]) < len(emojis[j]) {
-				emojis[i], emojis[j] = emojis[j], emojis[i]
+	var result strings.Builder
+	result.Grow(len(text))
+
+	// Scan left to right, replacing the longest known emoji at each position
+	for i := 0; i < len(text); {
+		if emojiChar, alias, ok := emoji.MatchEmoji(text[i:]); ok {
+			i += len(emojiChar)
+			if emoticon, ok := p.decodedEmoticon(emojiChar, &result, before, text[i:]); ok {
+				alias = emoticon
 			}
+
+			result.WriteString(alias)
+			continue
 		}
-	}
 
-	for _, emojiChar := range emojis {
-		if strings.Contains(result, emojiChar) {
-			alias := reverseMap[emojiChar]
-			result = strings.ReplaceAll(result, emojiChar, alias)
+		if text[i] == '<' {
+			if match := discordEmoji.FindStringSubmatch(text[i:]); match != nil {
+				result.WriteString(":" + match[2] + ":")
+				i += len(match[0])
+				continue
+			}
 		}
+
+		_, size := utf8.DecodeRuneInString(text[i:])
+		result.WriteString(text[i : i+size])
+		i += size
 	}
 
-	return result
+	return result.String()
 }
 
 // Decode is a convenience function that creates a processor and decodes text
@@ -7,6 +7,8 @@
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
 )
 
 // ProcessorTestSuite defines the test suite
@@ -310,6 +312,16 @@ func (suite *ProcessorTestSuite) TestBasicDecoding() {
 	}
 }
 
+// TestDecodingUnicodeData tests decoding emoji that only the Unicode data names
+func (suite *ProcessorTestSuite) TestDecodingUnicodeData() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	result := suite.processor.Decode("thanks 🫶🏽 🧑‍💻")
+	assert.Equal(suite.T(), "thanks :heart_hands_medium_skin_tone: :technologist:", result)
+}
+
 // TestConvenienceDecodeFunction tests the package-level Decode function
 func (suite *ProcessorTestSuite) TestConvenienceDecodeFunction() {
 	input := "Hello 😄 world 🚀"
@@ -354,6 +366,40 @@ func (suite *ProcessorTestSuite) TestDecodePerformance() {
 	assert.NotContains(suite.T(), result, "💯")
 }
 
+// TestSegments tests splitting text into literal text and alias segments
+func (suite *ProcessorTestSuite) TestSegments() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	text := "Hi :wave::skin-tone-4: at 12:30 :nope:smile: :x"
+	segments := suite.processor.Segments(text)
+
+	assert.Equal(suite.T(), []Segment{
+		{Kind: SegmentText, Source: "Hi ", Text: "Hi ", Offset: 0},
+		{Kind: SegmentEmoji, Source: ":wave::skin-tone-4:", Text: "👋🏽", Offset: 3, Alias: ":wave:"},
+		{Kind: SegmentText, Source: " at 12:30 ", Text: " at 12:30 ", Offset: 22},
+		{Kind: SegmentUnknown, Source: ":nope", Text: ":nope", Offset: 32, Alias: ":nope:"},
+		{Kind: SegmentEmoji, Source: ":smile:", Text: "😄", Offset: 37, Alias: ":smile:"},
+		{Kind: SegmentText, Source: " :x", Text: " :x", Offset: 44},
+	}, segments)
+
+	var source, output strings.Builder
+	for _, segment := range segments {
+		assert.Equal(suite.T(), segment.Source, text[segment.Offset:segment.Offset+len(segment.Source)])
+		source.WriteString(segment.Source)
+		output.WriteString(segment.Text)
+	}
+
+	assert.Equal(suite.T(), text, source.String())
+	assert.Equal(suite.T(), suite.processor.Process(text), output.String())
+	assert.Empty(suite.T(), suite.processor.Segments(""))
+
+	assert.True(suite.T(), segments[3].IsUnknownAlias())
+	assert.False(suite.T(), segments[1].IsUnknownAlias())
+	assert.False(suite.T(), suite.processor.Segments("12:300:45")[1].IsUnknownAlias(), "Numbers are not aliases")
+}
+
 // TestDecodeEdgeCases tests edge cases for decoding
 func (suite *ProcessorTestSuite) TestDecodeEdgeCases() {
 	tests := []struct {
@@ -0,0 +1,54 @@
+package emojify
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// SegmentKind identifies what a Segment of processed text contains
+type SegmentKind int
+
+const (
+	// SegmentText is literal text that Process leaves unchanged
+	SegmentText SegmentKind = iota
+	// SegmentEmoji is an alias that Process replaces with an emoji, a
+	// gitmoji inserted before a conventional commit (with an empty Source), or
+	// with WithFallback an emoji replaced by text
+	SegmentEmoji
+	// SegmentUnknown is a complete :alias: token that Process leaves unchanged,
+	// either because the alias is unknown or because its emoji is filtered out
+	SegmentUnknown
+	// SegmentCustom is a custom emoji, an alias registered with WithCustomEmoji
+	// or a Discord <:name:id> token, left unchanged except in HTML and Markdown output
+	SegmentCustom
+)
+
+// Segment is a piece of text produced by Processor.Segments
+type Segment struct {
+	Kind SegmentKind
+	// Source is the original text of the segment
+	Source string
+	// Text is the output for the segment: the emoji (or its fallback text) for SegmentEmoji, otherwise Source
+	Text string
+	// Offset is the byte offset of Source in the processed text
+	Offset int
+	// Alias is the :alias: token for SegmentEmoji, SegmentUnknown and SegmentCustom segments
+	//
+	// For unknown aliases it may extend one byte past Source, when the closing
+	// colon also opens the next alias.
+	Alias string
+	// Image is the image URL of a SegmentCustom segment
+	Image string
+}
+
+// IsUnknownAlias reports whether the segment is an alias-like token that isn't a known alias
+//
+// Tokens without letters, such as the "30" in a time like 12:30:45, and
+// known aliases filtered out by processor options are not reported.
+func (s Segment) IsUnknownAlias() bool {
+	return s.Kind == SegmentUnknown &&
+		emoji.GetEmoji(s.Alias) == s.Alias &&
+		strings.ContainsFunc(s.Alias, unicode.IsLetter)
+}
@@ -0,0 +1,192 @@
+package emojify
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+const (
+	// maxPendingLength is how much text without whitespace a StreamConverter holds back before converting part of it anyway
+	maxPendingLength = 64 << 10
+
+	// maxTokenLength is longer than any alias, emoji sequence or escape, which are never split when text is cut
+	maxTokenLength = 1 << 10
+)
+
+// StreamConverter applies a conversion to text that arrives in chunks
+//
+// Aliases and emoji never contain whitespace, so text is held back from the
+// last whitespace character of each chunk until more input or Flush arrives.
+// Conversions that depend on where lines start hold text back from the last
+// newline instead. Each piece is converted knowing the character before it,
+// which gives the same output as converting the whole text at once. Runs of
+// more than maxPendingLength bytes are cut at whitespace or before their last
+// maxTokenLength bytes instead, so memory stays bounded.
+type StreamConverter struct {
+	// convert converts a piece of text that follows the character before, or 0 at the start
+	convert func(text string, before rune) string
+	// cut returns where text without whitespace can be cut, at most len(text) - maxTokenLength
+	cut func(string) int
+	// lines holds text back to the start of its last line rather than its last whitespace
+	lines   bool
+	before  rune
+	pending strings.Builder
+}
+
+// ProcessStream creates a stream converter for Process
+//
+// Text without whitespace is cut outside the aliases Process replaces. With
+// WithCommitTypes or WithFixColumns, text is held back to the start of each
+// line, so conventional commit prefixes are only matched where lines start
+// and the padding of a line is corrected as a whole.
+func (p *Processor) ProcessStream() *StreamConverter {
+	return &StreamConverter{convert: p.process, cut: p.processCutPoint, lines: p.commitTypes != nil || p.fixColumns}
+}
+
+// DecodeStream creates a stream converter for Decode
+func (p *Processor) DecodeStream() *StreamConverter {
+	return &StreamConverter{convert: p.decode, cut: cutPoint}
+}
+
+// Write adds a chunk of text and returns the converted output that is now complete
+func (s *StreamConverter) Write(chunk string) string {
+	s.pending.WriteString(chunk)
+
+	// Held back text has no whitespace, or no newline when holding lines, so only the new chunk needs searching
+	text := s.pending.String()
+	end := 0
+	if s.lines {
+		if chunkEnd := strings.LastIndexByte(chunk, '\n'); chunkEnd >= 0 {
+			end = len(text) - len(chunk) + chunkEnd + 1
+		}
+	} else if chunkEnd := whitespaceEnd(chunk); chunkEnd > 0 {
+		end = len(text) - len(chunk) + chunkEnd
+	}
+
+	if end == 0 && len(text) > maxPendingLength {
+		if s.lines {
+			end = whitespaceEnd(text)
+		}
+
+		if end == 0 {
+			end = s.cut(text)
+		}
+	}
+
+	if end == 0 {
+		return ""
+	}
+
+	s.pending.Reset()
+	s.pending.WriteString(text[end:])
+
+	return s.release(text[:end])
+}
+
+// Flush converts and returns any text still held back
+func (s *StreamConverter) Flush() string {
+	text := s.pending.String()
+	s.pending.Reset()
+
+	if text == "" {
+		return ""
+	}
+
+	return s.release(text)
+}
+
+// release converts text that is complete, remembering its last character for the text that follows
+func (s *StreamConverter) release(text string) string {
+	output := s.convert(text, s.before)
+	s.before, _ = utf8.DecodeLastRuneInString(text)
+
+	return output
+}
+
+// whitespaceEnd returns the length of text up to and including its last whitespace character, or 0 without one
+func whitespaceEnd(text string) int {
+	end := strings.LastIndexFunc(text, unicode.IsSpace)
+	if end < 0 {
+		return 0
+	}
+
+	_, size := utf8.DecodeRuneInString(text[end:])
+
+	return end + size
+}
+
+// processCutPoint returns where to cut text without whitespace for Process
+//
+// The cut is placed next to the last alias replaced before the limit, which
+// splitting there can't change, and otherwise found by cutPoint.
+func (p *Processor) processCutPoint(text string) int {
+	limit := len(text) - maxTokenLength
+
+	best := 0
+	for _, segment := range p.Segments(text) {
+		if segment.Offset > limit {
+			break
+		}
+
+		if segment.Kind == SegmentEmoji || segment.Kind == SegmentCustom {
+			best = segment.Offset
+			if end := segment.Offset + len(segment.Source); end <= limit {
+				best = end
+			}
+		}
+	}
+
+	if best > limit-maxTokenLength {
+		return best
+	}
+
+	return cutPoint(text)
+}
+
+// cutPoint returns where to cut text without whitespace, keeping at least its last maxTokenLength bytes
+//
+// The cut preferably follows an ASCII character that ends any alias, escape
+// or Discord emoji, such as "." or "/", and otherwise lies between two letters
+// or digits, which never splits an emoji sequence.
+func cutPoint(text string) int {
+	limit := len(text) - maxTokenLength
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+
+	betweenLetters := 0
+	for i := limit; i > 0 && i > limit-maxTokenLength; i-- {
+		if !utf8.RuneStart(text[i]) {
+			continue
+		}
+
+		before, _ := utf8.DecodeLastRuneInString(text[:i])
+		if before < utf8.RuneSelf && endsTokens(before) {
+			return i
+		}
+
+		after, _ := utf8.DecodeRuneInString(text[i:])
+		if betweenLetters == 0 && isLetterOrDigit(before) && isLetterOrDigit(after) {
+			betweenLetters = i
+		}
+	}
+
+	if betweenLetters > 0 {
+		return betweenLetters
+	}
+
+	return limit
+}
+
+// endsTokens reports whether an ASCII character can't be part of an alias, escape or Discord emoji
+func endsTokens(char rune) bool {
+	return !emoji.IsValidEmojiChar(char) && !strings.ContainsRune(`:<\&#{`, char)
+}
+
+// isLetterOrDigit reports whether a character is a letter or digit, which emoji sequences never contain
+func isLetterOrDigit(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char)
+}
@@ -0,0 +1,159 @@
+package emojify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+)
+
+// StreamTestSuite defines the test suite for chunked conversion
+type StreamTestSuite struct {
+	suite.Suite
+}
+
+// convertChunks feeds chunks through a stream converter and joins its output
+func convertChunks(converter *StreamConverter, chunks []string) string {
+	var result strings.Builder
+	for _, chunk := range chunks {
+		result.WriteString(converter.Write(chunk))
+	}
+
+	result.WriteString(converter.Flush())
+
+	return result.String()
+}
+
+// TestSplitAliases tests that aliases split across chunks are still encoded
+func (suite *StreamTestSuite) TestSplitAliases() {
+	tests := []struct {
+		name   string
+		chunks []string
+	}{
+		{name: "single chunk", chunks: []string{"Deploy :rocket: done :tada:\n"}},
+		{name: "split inside alias", chunks: []string{"Deploy :roc", "ket: done :ta", "da:\n"}},
+		{name: "split at colons", chunks: []string{"Deploy :", "rocket", ":", " done :tada:", "\n"}},
+		{name: "no trailing whitespace", chunks: []string{"Deploy :rocket: done :ta", "da:"}},
+		{name: "one character at a time", chunks: strings.Split("Deploy :rocket: done :tada:\n", "")},
+	}
+
+	processor := NewProcessor()
+	whole := processor.Process(strings.Join(tests[0].chunks, ""))
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			expected := processor.Process(strings.Join(tt.chunks, ""))
+			assert.Equal(suite.T(), expected, convertChunks(processor.ProcessStream(), tt.chunks))
+		})
+	}
+
+	assert.Equal(suite.T(), "Deploy 🚀 done 🎉\n", whole)
+}
+
+// TestSplitEmoji tests that emoji sequences split across chunks are still decoded
+func (suite *StreamTestSuite) TestSplitEmoji() {
+	processor := NewProcessor()
+	text := "Team 👨‍👩‍👧 ships 🏳️‍🌈 today"
+
+	// Split between every rune, including inside ZWJ sequences
+	var chunks []string
+	for _, char := range text {
+		chunks = append(chunks, string(char))
+	}
+
+	assert.Equal(suite.T(), processor.Decode(text), convertChunks(processor.DecodeStream(), chunks))
+}
+
+// TestLineStarts tests that text is only treated as starting a line where it does
+func (suite *StreamTestSuite) TestLineStarts() {
+	processor := NewProcessor(WithCommitTypes(emoji.DefaultCommitTypes()), WithEmoticons(emoji.DefaultEmoticons()))
+	text := "fix(api)!: handle feat: something :)\nfeat(ui scope): add :tada:\nx:) <3\n"
+
+	tests := []struct {
+		name   string
+		chunks []string
+	}{
+		{name: "words", chunks: strings.SplitAfter(text, " ")},
+		{name: "one character at a time", chunks: strings.Split(text, "")},
+		{name: "split before emoticon", chunks: []string{"fix: x", ":) ", "feat: y\n"}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			expected := processor.Process(strings.Join(tt.chunks, ""))
+			assert.Equal(suite.T(), expected, convertChunks(processor.ProcessStream(), tt.chunks))
+		})
+	}
+
+	assert.Equal(suite.T(), "🐛 fix(api)!: handle feat: something 🙂\n", processor.Process(strings.SplitAfter(text, "\n")[0]))
+
+	decoded := processor.Process(text)
+	assert.Equal(suite.T(), processor.Decode(decoded), convertChunks(processor.DecodeStream(), strings.Split(decoded, "")))
+}
+
+// TestNoWhitespace tests that text without whitespace is converted without holding all of it back
+func (suite *StreamTestSuite) TestNoWhitespace() {
+	processor := NewProcessor()
+
+	tests := []struct {
+		name string
+		unit string
+	}{
+		{name: "punctuation", unit: "deploy.:rocket:/"},
+		{name: "letters only", unit: "shipit:rocket:"},
+		{name: "emoji", unit: "ok🚀👨‍👩‍👧"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			text := strings.Repeat(tt.unit, 3*maxPendingLength/len(tt.unit))
+			converter := processor.ProcessStream()
+
+			var result strings.Builder
+			released := 0
+			for i := 0; i < len(text); i += 100 {
+				output := converter.Write(text[i:min(i+100, len(text))])
+				if output != "" {
+					released++
+				}
+
+				result.WriteString(output)
+				assert.LessOrEqual(suite.T(), converter.pending.Len(), maxPendingLength+100)
+			}
+
+			result.WriteString(converter.Flush())
+
+			assert.Positive(suite.T(), released, "Output is released before Flush")
+			assert.Equal(suite.T(), processor.Process(text), result.String())
+		})
+	}
+
+	decoded := strings.Repeat("ok🚀👨‍👩‍👧<:blobcat:1234>", maxPendingLength/16)
+	converter := processor.DecodeStream()
+	result := converter.Write(decoded)
+	assert.NotEmpty(suite.T(), result, "Output is released before Flush")
+	assert.Equal(suite.T(), processor.Decode(decoded), result+converter.Flush())
+}
+
+// TestWriteReturnsCompleteText tests that output is released at whitespace
+func (suite *StreamTestSuite) TestWriteReturnsCompleteText() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	converter := NewProcessor().ProcessStream()
+
+	assert.Equal(suite.T(), "", converter.Write(":rock"))
+	assert.Equal(suite.T(), "🪨 ", converter.Write(": :smi"))
+	assert.Equal(suite.T(), "", converter.Write("le:"))
+	assert.Equal(suite.T(), "😄", converter.Flush())
+	assert.Equal(suite.T(), "", converter.Flush())
+}
+
+// TestStream runs all stream tests
+func TestStream(t *testing.T) {
+	suite.Run(t, new(StreamTestSuite))
+}
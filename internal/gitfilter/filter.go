@@ -0,0 +1,171 @@
+package gitfilter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// binaryCheckSize is how much of a file is checked for NUL bytes, matching git's own binary detection
+const binaryCheckSize = 8000
+
+// Filter serves git's long-running filter process protocol (see gitattributes(5))
+//
+// A single process handles every clean and smudge request of a git command,
+// instead of git starting a new filter for each file.
+type Filter struct {
+	commands map[string]func(string) string
+}
+
+// New creates a filter that applies clean when files are staged and smudge when they are checked out
+//
+// A nil function disables that command, so git leaves those files unchanged.
+func New(clean, smudge func(string) string) *Filter {
+	f := &Filter{commands: make(map[string]func(string) string)}
+
+	if clean != nil {
+		f.commands["clean"] = clean
+	}
+
+	if smudge != nil {
+		f.commands["smudge"] = smudge
+	}
+
+	return f
+}
+
+// Run performs the handshake on r and w, then serves requests until git closes the input
+func (f *Filter) Run(r io.Reader, w io.Writer) error {
+	reader := newPktReader(r)
+	writer := newPktWriter(w)
+
+	if err := f.handshake(reader, writer); err != nil {
+		return err
+	}
+
+	for {
+		request, err := reader.readList()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := f.serve(request, reader, writer); err != nil {
+			return err
+		}
+	}
+}
+
+// handshake exchanges the welcome message, version and capabilities
+func (f *Filter) handshake(reader *pktReader, writer *pktWriter) error {
+	welcome, err := reader.readList()
+	if err != nil {
+		return fmt.Errorf("failed to read filter handshake: %w", err)
+	}
+
+	if len(welcome) == 0 || welcome[0] != "git-filter-client" {
+		return fmt.Errorf("unexpected filter handshake %q", welcome)
+	}
+
+	if !slices.Contains(welcome[1:], "version=2") {
+		return fmt.Errorf("unsupported filter protocol versions %q", welcome[1:])
+	}
+
+	if err := writer.writeList("git-filter-server", "version=2"); err != nil {
+		return err
+	}
+
+	if err := writer.sync(); err != nil {
+		return err
+	}
+
+	capabilities, err := reader.readList()
+	if err != nil {
+		return fmt.Errorf("failed to read filter capabilities: %w", err)
+	}
+
+	var supported []string
+	for _, capability := range capabilities {
+		if _, ok := f.commands[strings.TrimPrefix(capability, "capability=")]; ok {
+			supported = append(supported, capability)
+		}
+	}
+
+	if err := writer.writeList(supported...); err != nil {
+		return err
+	}
+
+	return writer.sync()
+}
+
+// serve reads the content of one request and replies with the converted content
+//
+// Git sends the whole file before reading the reply, so the content is read
+// in full and converted once before anything is written back. Replying
+// earlier could deadlock once both pipes are full.
+func (f *Filter) serve(request []string, reader *pktReader, writer *pktWriter) error {
+	var command string
+	for _, line := range request {
+		if value, ok := strings.CutPrefix(line, "command="); ok {
+			command = value
+		}
+	}
+
+	var content bytes.Buffer
+	for {
+		data, flush, err := reader.readPacket()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+
+			return err
+		}
+
+		if flush {
+			break
+		}
+
+		content.Write(data)
+	}
+
+	convert, ok := f.commands[command]
+	if !ok {
+		if err := writer.writeList("status=error"); err != nil {
+			return err
+		}
+
+		return writer.sync()
+	}
+
+	// Leave binary files untouched, deciding like git does
+	output := content.String()
+	if bytes.IndexByte(content.Bytes()[:min(content.Len(), binaryCheckSize)], 0) < 0 {
+		output = convert(output)
+	}
+
+	if err := writer.writeList("status=success"); err != nil {
+		return err
+	}
+
+	if err := writer.writeData(output); err != nil {
+		return err
+	}
+
+	if err := writer.writeFlush(); err != nil {
+		return err
+	}
+
+	// An empty list keeps the status sent before the content
+	if err := writer.writeFlush(); err != nil {
+		return err
+	}
+
+	return writer.sync()
+}
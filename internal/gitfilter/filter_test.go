@@ -0,0 +1,189 @@
+package gitfilter
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// FilterTestSuite defines the test suite for the filter process protocol
+type FilterTestSuite struct {
+	suite.Suite
+	filter *Filter
+}
+
+// SetupTest creates a filter that stores aliases and checks out emoji
+func (suite *FilterTestSuite) SetupTest() {
+	processor := emojify.NewProcessor()
+	suite.filter = New(processor.Decode, processor.Process)
+}
+
+// client builds the input git would send to a filter process
+type client struct {
+	buffer bytes.Buffer
+	writer *pktWriter
+}
+
+// newClient creates a client that has completed the handshake, requesting the given capabilities
+func newClient(capabilities ...string) *client {
+	c := &client{}
+	c.writer = newPktWriter(&c.buffer)
+	_ = c.writer.writeList("git-filter-client", "version=2")
+	_ = c.writer.writeList(capabilities...)
+	return c
+}
+
+// request adds a command for a file with the given content
+func (c *client) request(command, pathname, content string) *client {
+	_ = c.writer.writeList("command="+command, "pathname="+pathname)
+	_ = c.writer.writeData(content)
+	_ = c.writer.writeFlush()
+	return c
+}
+
+// input returns everything the client sent
+func (c *client) input() io.Reader {
+	_ = c.writer.sync()
+	return &c.buffer
+}
+
+// response is the reply to a single request
+type response struct {
+	status  string
+	content string
+}
+
+// run runs the filter and parses its handshake and responses
+func (suite *FilterTestSuite) run(input io.Reader, requests int) ([]string, []response) {
+	var output bytes.Buffer
+	require.NoError(suite.T(), suite.filter.Run(input, &output))
+
+	reader := newPktReader(&output)
+
+	welcome, err := reader.readList()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"git-filter-server", "version=2"}, welcome)
+
+	capabilities, err := reader.readList()
+	require.NoError(suite.T(), err)
+
+	var responses []response
+	for range requests {
+		status, err := reader.readList()
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), status, 1)
+
+		r := response{status: status[0]}
+		if r.status == "status=success" {
+			var content strings.Builder
+			for {
+				data, flush, err := reader.readPacket()
+				require.NoError(suite.T(), err)
+				if flush {
+					break
+				}
+
+				content.Write(data)
+			}
+
+			r.content = content.String()
+
+			final, err := reader.readList()
+			require.NoError(suite.T(), err)
+			assert.Empty(suite.T(), final, "The status should be kept")
+		}
+
+		responses = append(responses, r)
+	}
+
+	_, _, err = reader.readPacket()
+	assert.Equal(suite.T(), io.EOF, err, "No unexpected output")
+
+	return capabilities, responses
+}
+
+// TestCleanAndSmudge tests serving several files in one process
+func (suite *FilterTestSuite) TestCleanAndSmudge() {
+	large := strings.Repeat("Deploy :rocket: done\n", 10000)
+
+	input := newClient("capability=clean", "capability=smudge", "capability=delay").
+		request("smudge", "README.md", "Deploy :rocket: :tada:\n").
+		request("clean", "README.md", "Deploy 🚀 🎉\n").
+		request("smudge", "large.md", large).
+		input()
+
+	capabilities, responses := suite.run(input, 3)
+	assert.Equal(suite.T(), []string{"capability=clean", "capability=smudge"}, capabilities)
+
+	require.Len(suite.T(), responses, 3)
+	assert.Equal(suite.T(), response{status: "status=success", content: "Deploy 🚀 🎉\n"}, responses[0])
+	assert.Equal(suite.T(), response{status: "status=success", content: "Deploy :rocket: :tada:\n"}, responses[1])
+	assert.Equal(suite.T(), strings.ReplaceAll(large, ":rocket:", "🚀"), responses[2].content)
+}
+
+// TestEmptyAndBinaryFiles tests files that need no conversion
+func (suite *FilterTestSuite) TestEmptyAndBinaryFiles() {
+	binary := "\x00:rocket:"
+
+	input := newClient("capability=smudge").
+		request("smudge", "empty.md", "").
+		request("smudge", "image.png", binary).
+		input()
+
+	_, responses := suite.run(input, 2)
+	assert.Equal(suite.T(), response{status: "status=success"}, responses[0])
+	assert.Equal(suite.T(), response{status: "status=success", content: binary}, responses[1])
+}
+
+// TestDisabledCommand tests that a disabled command is neither advertised nor served
+func (suite *FilterTestSuite) TestDisabledCommand() {
+	suite.filter = New(nil, emojify.NewProcessor().Process)
+
+	input := newClient("capability=clean", "capability=smudge").
+		request("clean", "README.md", "🚀").
+		request("smudge", "README.md", ":rocket:").
+		input()
+
+	capabilities, responses := suite.run(input, 2)
+	assert.Equal(suite.T(), []string{"capability=smudge"}, capabilities)
+	assert.Equal(suite.T(), response{status: "status=error"}, responses[0])
+	assert.Equal(suite.T(), response{status: "status=success", content: "🚀"}, responses[1])
+}
+
+// TestHandshakeErrors tests rejection of unsupported clients
+func (suite *FilterTestSuite) TestHandshakeErrors() {
+	for name, lines := range map[string][]string{
+		"wrong welcome": {"git-filter-server", "version=2"},
+		"wrong version": {"git-filter-client", "version=3"},
+	} {
+		var buffer bytes.Buffer
+		writer := newPktWriter(&buffer)
+		_ = writer.writeList(lines...)
+		_ = writer.sync()
+
+		assert.Error(suite.T(), suite.filter.Run(&buffer, io.Discard), name)
+	}
+
+	// Input ending in the middle of a request is an error
+	var buffer bytes.Buffer
+	writer := newPktWriter(&buffer)
+	_ = writer.writeList("git-filter-client", "version=2")
+	_ = writer.writeList("capability=smudge")
+	_ = writer.writeList("command=smudge", "pathname=a.md")
+	_ = writer.writeData("partial")
+	_ = writer.sync()
+
+	assert.ErrorIs(suite.T(), suite.filter.Run(&buffer, io.Discard), io.ErrUnexpectedEOF)
+}
+
+// TestFilter runs all filter process tests
+func TestFilter(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
@@ -0,0 +1,128 @@
+package gitfilter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxPacketData is the largest payload of a single pkt-line
+	maxPacketData = 65516
+
+	// flushPacket ends a list of packets
+	flushPacket = "0000"
+)
+
+// pktReader reads git pkt-line packets
+type pktReader struct {
+	r *bufio.Reader
+}
+
+// newPktReader creates a pkt-line reader
+func newPktReader(r io.Reader) *pktReader {
+	return &pktReader{r: bufio.NewReader(r)}
+}
+
+// readPacket reads one packet, reporting flush packets with flush set
+//
+// io.EOF is returned only if the input ends cleanly between packets.
+func (p *pktReader) readPacket() (data []byte, flush bool, err error) {
+	var header [4]byte
+	if _, err := io.ReadFull(p.r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, false, fmt.Errorf("truncated pkt-line header")
+		}
+
+		return nil, false, err
+	}
+
+	length, err := strconv.ParseUint(string(header[:]), 16, 16)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid pkt-line header %q", header[:])
+	}
+
+	switch {
+	case length == 0:
+		return nil, true, nil
+	case length < 4 || length > maxPacketData+4:
+		return nil, false, fmt.Errorf("invalid pkt-line length %d", length)
+	}
+
+	data = make([]byte, length-4)
+	if _, err := io.ReadFull(p.r, data); err != nil {
+		return nil, false, fmt.Errorf("truncated pkt-line: %w", err)
+	}
+
+	return data, false, nil
+}
+
+// readList reads text packets up to the next flush, without their trailing newlines
+func (p *pktReader) readList() ([]string, error) {
+	var lines []string
+
+	for {
+		data, flush, err := p.readPacket()
+		if err != nil {
+			if err == io.EOF && len(lines) > 0 {
+				return nil, io.ErrUnexpectedEOF
+			}
+
+			return nil, err
+		}
+
+		if flush {
+			return lines, nil
+		}
+
+		lines = append(lines, strings.TrimSuffix(string(data), "\n"))
+	}
+}
+
+// pktWriter writes git pkt-line packets
+type pktWriter struct {
+	w *bufio.Writer
+}
+
+// newPktWriter creates a pkt-line writer
+func newPktWriter(w io.Writer) *pktWriter {
+	return &pktWriter{w: bufio.NewWriter(w)}
+}
+
+// writeData writes data as a sequence of packets of at most maxPacketData bytes
+func (p *pktWriter) writeData(data string) error {
+	for len(data) > 0 {
+		n := min(len(data), maxPacketData)
+		if _, err := fmt.Fprintf(p.w, "%04x%s", n+4, data[:n]); err != nil {
+			return err
+		}
+
+		data = data[n:]
+	}
+
+	return nil
+}
+
+// writeList writes text packets, each with a trailing newline, followed by a flush
+func (p *pktWriter) writeList(lines ...string) error {
+	for _, line := range lines {
+		if err := p.writeData(line + "\n"); err != nil {
+			return err
+		}
+	}
+
+	return p.writeFlush()
+}
+
+// writeFlush writes a flush packet
+func (p *pktWriter) writeFlush() error {
+	_, err := p.w.WriteString(flushPacket)
+	return err
+}
+
+// sync sends buffered packets to the underlying writer
+func (p *pktWriter) sync() error {
+	return p.w.Flush()
+}
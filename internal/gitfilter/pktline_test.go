@@ -0,0 +1,81 @@
+package gitfilter
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// PktLineTestSuite defines the test suite for pkt-line encoding
+type PktLineTestSuite struct {
+	suite.Suite
+}
+
+// TestRoundTrip tests that written packets are read back unchanged
+func (suite *PktLineTestSuite) TestRoundTrip() {
+	var buffer bytes.Buffer
+	writer := newPktWriter(&buffer)
+
+	require.NoError(suite.T(), writer.writeList("git-filter-server", "version=2"))
+	require.NoError(suite.T(), writer.sync())
+	assert.Equal(suite.T(), "0016git-filter-server\n000eversion=2\n0000", buffer.String())
+
+	reader := newPktReader(&buffer)
+	lines, err := reader.readList()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"git-filter-server", "version=2"}, lines)
+
+	_, _, err = reader.readPacket()
+	assert.Equal(suite.T(), io.EOF, err)
+}
+
+// TestLargeData tests that data is split into maximum size packets
+func (suite *PktLineTestSuite) TestLargeData() {
+	data := strings.Repeat("a", maxPacketData*2+10)
+
+	var buffer bytes.Buffer
+	writer := newPktWriter(&buffer)
+	require.NoError(suite.T(), writer.writeData(data))
+	require.NoError(suite.T(), writer.writeData(""))
+	require.NoError(suite.T(), writer.sync())
+
+	reader := newPktReader(&buffer)
+	var sizes []int
+	var read strings.Builder
+	for {
+		packet, flush, err := reader.readPacket()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(suite.T(), err)
+		require.False(suite.T(), flush)
+		sizes = append(sizes, len(packet))
+		read.Write(packet)
+	}
+
+	assert.Equal(suite.T(), []int{maxPacketData, maxPacketData, 10}, sizes)
+	assert.Equal(suite.T(), data, read.String())
+}
+
+// TestInvalidPackets tests rejection of malformed input
+func (suite *PktLineTestSuite) TestInvalidPackets() {
+	for _, input := range []string{"00", "zzzz", "0002", "0010short", "ffff"} {
+		_, _, err := newPktReader(strings.NewReader(input)).readPacket()
+		assert.Error(suite.T(), err, "Input %q", input)
+		assert.NotEqual(suite.T(), io.EOF, err, "Input %q", input)
+	}
+
+	_, err := newPktReader(strings.NewReader("0009hello")).readList()
+	assert.Equal(suite.T(), io.ErrUnexpectedEOF, err, "A list must end with a flush")
+}
+
+// TestPktLine runs all pkt-line tests
+func TestPktLine(t *testing.T) {
+	suite.Run(t, new(PktLineTestSuite))
+}
@@ -0,0 +1,248 @@
+package githook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// marker identifies hooks written by emojify so they are never mistaken for user hooks
+const marker = "# emojify-hook"
+
+// chainedSuffix is appended to an existing hook that emojify moves aside and runs first
+const chainedSuffix = ".pre-emojify"
+
+// Hooks lists the hooks emojify can install, in the order they run during a commit
+var Hooks = []string{"prepare-commit-msg", "commit-msg"}
+
+// ErrNotInstalled is returned when uninstalling a hook that emojify did not install
+var ErrNotInstalled = errors.New("hook was not installed by emojify")
+
+// Mode controls what the hook does with the commit message
+type Mode int
+
+const (
+	// ModeEncode converts aliases in the message to emoji
+	ModeEncode Mode = iota
+	// ModeDecode converts emoji in the message to aliases
+	ModeDecode
+	// ModeLint rejects messages containing unknown aliases
+	ModeLint
+)
+
+// String returns the name used for the mode on the command line
+func (m Mode) String() string {
+	switch m {
+	case ModeDecode:
+		return "decode"
+	case ModeLint:
+		return "lint"
+	default:
+		return "encode"
+	}
+}
+
+// ParseMode converts a mode name into a Mode
+func ParseMode(name string) (Mode, error) {
+	switch strings.ToLower(name) {
+	case "", "encode":
+		return ModeEncode, nil
+	case "decode":
+		return ModeDecode, nil
+	case "lint":
+		return ModeLint, nil
+	default:
+		return ModeEncode, fmt.Errorf("invalid mode %q (expected encode, decode or lint)", name)
+	}
+}
+
+// ValidateHook checks that emojify can install the named hook in the given mode
+func ValidateHook(hook string, mode Mode) error {
+	switch hook {
+	case "commit-msg":
+		return nil
+	case "prepare-commit-msg":
+		if mode == ModeLint {
+			return fmt.Errorf("lint mode requires the commit-msg hook, which sees the final message")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported hook %q (expected %s)", hook, strings.Join(Hooks, " or "))
+	}
+}
+
+// HooksDir returns the absolute hooks directory of the repository containing dir
+//
+// The directory honours core.hooksPath and is created if it doesn't exist.
+func HooksDir(dir string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--git-path", "hooks")
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("failed to find git hooks directory: %s", message)
+		}
+
+		return "", fmt.Errorf("failed to find git hooks directory: %w", err)
+	}
+
+	hooksDir := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(dir, hooksDir)
+	}
+
+	hooksDir, err = filepath.Abs(hooksDir)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	return hooksDir, nil
+}
+
+// CommentChar returns the core.commentChar setting of the repository containing dir
+//
+// DefaultCommentChar is returned when it isn't set, and AutoCommentChar is
+// returned as is, since the character depends on the message; RewriteMessage
+// resolves it.
+func CommentChar(dir string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", "core.commentChar")
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 && stderr.Len() == 0 {
+			return DefaultCommentChar, nil
+		}
+
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("failed to read core.commentChar: %s", message)
+		}
+
+		return "", fmt.Errorf("failed to read core.commentChar: %w", err)
+	}
+
+	commentChar := strings.TrimRight(string(output), "\r\n")
+	if commentChar == "" {
+		return DefaultCommentChar, nil
+	}
+
+	return commentChar, nil
+}
+
+// Script returns the shell script for a hook that runs executable in the given mode
+//
+// A hook that existed before installation is run first with the same
+// arguments, and the commit is aborted if it fails.
+func Script(hook string, mode Mode, executable string) string {
+	chained := hook + chainedSuffix
+
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&b, "%s: installed by \"emojify git install-hook\", remove with \"emojify git uninstall-hook\"\n", marker)
+	fmt.Fprintf(&b, "# mode: %s\n\n", mode)
+	fmt.Fprintf(&b, "if [ -x \"$(dirname \"$0\")/%s\" ]; then\n", chained)
+	fmt.Fprintf(&b, "\t\"$(dirname \"$0\")/%s\" \"$@\" || exit $?\n", chained)
+	b.WriteString("fi\n\n")
+	fmt.Fprintf(&b, "exec %s git run-hook --mode %s \"$1\"\n", shellQuote(filepath.ToSlash(executable)), mode)
+
+	return b.String()
+}
+
+// Install writes the hook into hooksDir, moving any existing hook aside to be chained
+//
+// Reinstalling replaces a previous emojify hook, so the mode can be changed
+// without disturbing a chained hook.
+func Install(hooksDir, hook string, mode Mode, executable string) error {
+	if err := ValidateHook(hook, mode); err != nil {
+		return err
+	}
+
+	path := filepath.Join(hooksDir, hook)
+	chained := path + chainedSuffix
+
+	installed, err := isInstalled(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return err
+	case !installed:
+		if _, err := os.Stat(chained); err == nil {
+			return fmt.Errorf("cannot chain existing %s hook: %s already exists", hook, chained)
+		}
+
+		if err := os.Rename(path, chained); err != nil {
+			return fmt.Errorf("failed to move existing %s hook aside: %w", hook, err)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(Script(hook, mode, executable)), 0o755); err != nil {
+		return fmt.Errorf("failed to write %s hook: %w", hook, err)
+	}
+
+	// WriteFile keeps the permissions of an existing file, so make sure the hook is executable
+	return os.Chmod(path, 0o755)
+}
+
+// Uninstall removes an emojify hook from hooksDir and restores any hook it chained
+//
+// It reports false if the hook isn't present, and returns ErrNotInstalled if
+// the hook exists but was not written by emojify.
+func Uninstall(hooksDir, hook string) (bool, error) {
+	path := filepath.Join(hooksDir, hook)
+	chained := path + chainedSuffix
+
+	installed, err := isInstalled(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	case err != nil:
+		return false, err
+	case !installed:
+		return false, fmt.Errorf("%s: %w", path, ErrNotInstalled)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return false, fmt.Errorf("failed to remove %s hook: %w", hook, err)
+	}
+
+	if _, err := os.Stat(chained); err == nil {
+		if err := os.Rename(chained, path); err != nil {
+			return true, fmt.Errorf("failed to restore chained %s hook: %w", hook, err)
+		}
+	}
+
+	return true, nil
+}
+
+// isInstalled reports whether the hook at path was written by emojify
+func isInstalled(path string) (bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Contains(content, []byte(marker)), nil
+}
+
+// shellQuote quotes s for use as a single POSIX shell word
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,168 @@
+package githook
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const userHook = "#!/bin/sh\necho user hook\n"
+
+// GitHookTestSuite defines the test suite for hook installation
+type GitHookTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+// SetupTest creates an empty hooks directory for each test
+func (suite *GitHookTestSuite) SetupTest() {
+	suite.dir = suite.T().TempDir()
+}
+
+// read returns the content of a file in the hooks directory
+func (suite *GitHookTestSuite) read(name string) string {
+	content, err := os.ReadFile(filepath.Join(suite.dir, name))
+	require.NoError(suite.T(), err)
+	return string(content)
+}
+
+// TestParseMode tests parsing of mode names
+func (suite *GitHookTestSuite) TestParseMode() {
+	for name, expected := range map[string]Mode{"": ModeEncode, "encode": ModeEncode, "Decode": ModeDecode, "lint": ModeLint} {
+		mode, err := ParseMode(name)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, mode, "Mode %q", name)
+	}
+
+	_, err := ParseMode("emojify")
+	assert.Error(suite.T(), err)
+
+	assert.NoError(suite.T(), ValidateHook("prepare-commit-msg", ModeDecode))
+	assert.Error(suite.T(), ValidateHook("prepare-commit-msg", ModeLint))
+	assert.Error(suite.T(), ValidateHook("pre-push", ModeEncode))
+}
+
+// TestScript tests the generated hook script
+func (suite *GitHookTestSuite) TestScript() {
+	script := Script("commit-msg", ModeDecode, "/opt/it's here/emojify")
+
+	assert.Contains(suite.T(), script, "#!/bin/sh\n")
+	assert.Contains(suite.T(), script, marker)
+	assert.Contains(suite.T(), script, `"$(dirname "$0")/commit-msg.pre-emojify" "$@" || exit $?`)
+	assert.Contains(suite.T(), script, `exec '/opt/it'\''s here/emojify' git run-hook --mode decode "$1"`)
+}
+
+// TestInstall tests installing into an empty hooks directory
+func (suite *GitHookTestSuite) TestInstall() {
+	require.NoError(suite.T(), Install(suite.dir, "commit-msg", ModeEncode, "/bin/emojify"))
+
+	info, err := os.Stat(filepath.Join(suite.dir, "commit-msg"))
+	require.NoError(suite.T(), err)
+	assert.NotZero(suite.T(), info.Mode()&0o100, "Hook should be executable")
+	assert.Contains(suite.T(), suite.read("commit-msg"), "--mode encode")
+
+	// Reinstalling changes the mode in place
+	require.NoError(suite.T(), Install(suite.dir, "commit-msg", ModeLint, "/bin/emojify"))
+	assert.Contains(suite.T(), suite.read("commit-msg"), "--mode lint")
+	assert.NoFileExists(suite.T(), filepath.Join(suite.dir, "commit-msg"+chainedSuffix))
+
+	assert.Error(suite.T(), Install(suite.dir, "prepare-commit-msg", ModeLint, "/bin/emojify"))
+}
+
+// TestInstallChainsExistingHook tests that an existing hook is preserved and restored
+func (suite *GitHookTestSuite) TestInstallChainsExistingHook() {
+	path := filepath.Join(suite.dir, "commit-msg")
+	require.NoError(suite.T(), os.WriteFile(path, []byte(userHook), 0o755))
+
+	require.NoError(suite.T(), Install(suite.dir, "commit-msg", ModeEncode, "/bin/emojify"))
+	assert.Equal(suite.T(), userHook, suite.read("commit-msg"+chainedSuffix))
+	assert.Contains(suite.T(), suite.read("commit-msg"), marker)
+
+	// Reinstalling keeps the chained hook
+	require.NoError(suite.T(), Install(suite.dir, "commit-msg", ModeDecode, "/bin/emojify"))
+	assert.Equal(suite.T(), userHook, suite.read("commit-msg"+chainedSuffix))
+
+	removed, err := Uninstall(suite.dir, "commit-msg")
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), removed)
+	assert.Equal(suite.T(), userHook, suite.read("commit-msg"))
+	assert.NoFileExists(suite.T(), path+chainedSuffix)
+}
+
+// TestInstallRefusesToOverwriteChainedHook tests that a second foreign hook is not clobbered
+func (suite *GitHookTestSuite) TestInstallRefusesToOverwriteChainedHook() {
+	path := filepath.Join(suite.dir, "commit-msg")
+	require.NoError(suite.T(), os.WriteFile(path, []byte(userHook), 0o755))
+	require.NoError(suite.T(), os.WriteFile(path+chainedSuffix, []byte(userHook), 0o755))
+
+	assert.Error(suite.T(), Install(suite.dir, "commit-msg", ModeEncode, "/bin/emojify"))
+	assert.Equal(suite.T(), userHook, suite.read("commit-msg"))
+}
+
+// TestUninstall tests removing hooks that are missing or not ours
+func (suite *GitHookTestSuite) TestUninstall() {
+	removed, err := Uninstall(suite.dir, "commit-msg")
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), removed)
+
+	require.NoError(suite.T(), os.WriteFile(filepath.Join(suite.dir, "commit-msg"), []byte(userHook), 0o755))
+
+	removed, err = Uninstall(suite.dir, "commit-msg")
+	assert.ErrorIs(suite.T(), err, ErrNotInstalled)
+	assert.False(suite.T(), removed)
+	assert.Equal(suite.T(), userHook, suite.read("commit-msg"))
+}
+
+// TestHooksDir tests locating the hooks directory of a repository
+func (suite *GitHookTestSuite) TestHooksDir() {
+	if _, err := exec.LookPath("git"); err != nil {
+		suite.T().Skip("git is not installed")
+	}
+
+	repo := suite.T().TempDir()
+	require.NoError(suite.T(), exec.Command("git", "init", "-q", repo).Run())
+
+	hooksDir, err := HooksDir(repo)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), filepath.IsAbs(hooksDir))
+	assert.DirExists(suite.T(), hooksDir)
+	assert.Equal(suite.T(), "hooks", filepath.Base(hooksDir))
+
+	_, err = HooksDir(suite.T().TempDir())
+	assert.Error(suite.T(), err, "A directory outside a repository has no hooks")
+}
+
+// TestCommentChar tests reading core.commentChar
+func (suite *GitHookTestSuite) TestCommentChar() {
+	if _, err := exec.LookPath("git"); err != nil {
+		suite.T().Skip("git is not installed")
+	}
+
+	repo := suite.T().TempDir()
+	require.NoError(suite.T(), exec.Command("git", "init", "-q", repo).Run())
+
+	suite.T().Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	suite.T().Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	commentChar, err := CommentChar(repo)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), DefaultCommentChar, commentChar)
+
+	for _, value := range []string{";", AutoCommentChar} {
+		require.NoError(suite.T(), exec.Command("git", "-C", repo, "config", "core.commentChar", value).Run())
+
+		commentChar, err = CommentChar(repo)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), value, commentChar)
+	}
+}
+
+// TestGitHook runs all hook installation tests
+func TestGitHook(t *testing.T) {
+	suite.Run(t, new(GitHookTestSuite))
+}
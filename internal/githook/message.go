@@ -0,0 +1,129 @@
+package githook
+
+import (
+	"strings"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+const (
+	// scissors marks the start of the diff that "git commit --verbose" appends to the message
+	scissors = "------------------------ >8 ------------------------"
+
+	// DefaultCommentChar starts comment lines unless core.commentChar says otherwise
+	DefaultCommentChar = "#"
+
+	// AutoCommentChar is the core.commentChar value that lets git pick a character the message doesn't use
+	AutoCommentChar = "auto"
+
+	// autoCommentChars are the characters git picks from for AutoCommentChar, in order of preference
+	autoCommentChars = "#;@!$%^&|:"
+)
+
+// RewriteMessage applies convert to the lines of a commit message that git keeps
+//
+// Comment lines, which start with commentChar as read by CommentChar, and
+// everything after the scissors line are left untouched, since git strips
+// them from the final message.
+func RewriteMessage(message, commentChar string, convert func(string) string) string {
+	commentChar = messageCommentChar(message, commentChar)
+
+	var b strings.Builder
+	b.Grow(len(message))
+
+	lines := strings.SplitAfter(message, "\n")
+	for i, line := range lines {
+		if isScissors(line, commentChar) {
+			for _, rest := range lines[i:] {
+				b.WriteString(rest)
+			}
+
+			break
+		}
+
+		if isComment(line, commentChar) {
+			b.WriteString(line)
+			continue
+		}
+
+		b.WriteString(convert(line))
+	}
+
+	return b.String()
+}
+
+// UnknownAliases returns the alias-like tokens in a commit message that aren't known aliases
+//
+// Only the lines git keeps are checked, as with RewriteMessage.
+func UnknownAliases(message, commentChar string, processor *emojify.Processor) []string {
+	var unknown []string
+
+	RewriteMessage(message, commentChar, func(line string) string {
+		for _, segment := range processor.Segments(line) {
+			if segment.IsUnknownAlias() {
+				unknown = append(unknown, segment.Alias)
+			}
+		}
+
+		return line
+	})
+
+	return unknown
+}
+
+// messageCommentChar resolves AutoCommentChar to the character git picked for a message
+//
+// Git picks the first of autoCommentChars that starts no line the user wrote,
+// and ends the message with its instructions, or with the scissors line and
+// diff of "git commit --verbose". The character is the one starting the
+// scissors line or the last line, provided every character git prefers starts
+// some line. Otherwise the message has no comments.
+func messageCommentChar(message, commentChar string) string {
+	if commentChar != AutoCommentChar {
+		return commentChar
+	}
+
+	lines := strings.Split(strings.TrimRight(message, "\r\n"), "\n")
+
+	starts := make(map[byte]bool, len(lines))
+	for _, line := range lines {
+		if line != "" {
+			starts[line[0]] = true
+		}
+	}
+
+	candidate := lines[len(lines)-1]
+	for _, line := range lines {
+		if strings.Contains(line, scissors) {
+			candidate = line
+			break
+		}
+	}
+
+	if candidate == "" {
+		return DefaultCommentChar
+	}
+
+	i := strings.IndexByte(autoCommentChars, candidate[0])
+	if i < 0 {
+		return DefaultCommentChar
+	}
+
+	for j := 0; j < i; j++ {
+		if !starts[autoCommentChars[j]] {
+			return DefaultCommentChar
+		}
+	}
+
+	return candidate[:1]
+}
+
+// isComment reports whether a message line is a git comment
+func isComment(line, commentChar string) bool {
+	return strings.HasPrefix(line, commentChar)
+}
+
+// isScissors reports whether a message line is the "git commit --verbose" cut line
+func isScissors(line, commentChar string) bool {
+	return isComment(line, commentChar) && strings.Contains(line, scissors)
+}
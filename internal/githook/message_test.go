@@ -0,0 +1,97 @@
+package githook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+const verboseMessage = `:sparkles: add hooks :rocket:
+
+Body with :tada:
+# Please enter the commit message for your changes. :rocket:
+# ------------------------ >8 ------------------------
+# Do not modify or remove the line above.
++ added :rocket: to README
+`
+
+// MessageTestSuite defines the test suite for commit message processing
+type MessageTestSuite struct {
+	suite.Suite
+	processor *emojify.Processor
+}
+
+// SetupTest creates a fresh processor for each test
+func (suite *MessageTestSuite) SetupTest() {
+	suite.processor = emojify.NewProcessor()
+}
+
+// TestRewriteMessage tests that only the kept message lines are converted
+func (suite *MessageTestSuite) TestRewriteMessage() {
+	expected := `✨ add hooks 🚀
+
+Body with 🎉
+# Please enter the commit message for your changes. :rocket:
+# ------------------------ >8 ------------------------
+# Do not modify or remove the line above.
++ added :rocket: to README
+`
+
+	assert.Equal(suite.T(), expected, RewriteMessage(verboseMessage, DefaultCommentChar, suite.processor.Process))
+	assert.Equal(suite.T(), "🐛 fix\r\n# :bug:\r\n", RewriteMessage(":bug: fix\r\n# :bug:\r\n", DefaultCommentChar, suite.processor.Process))
+	assert.Equal(suite.T(), "", RewriteMessage("", DefaultCommentChar, suite.processor.Process))
+}
+
+// TestRewriteMessageCommentChar tests messages written with a core.commentChar other than "#"
+func (suite *MessageTestSuite) TestRewriteMessageCommentChar() {
+	message := "# :bug: heading\n; Please enter the commit message :rocket:\n"
+	assert.Equal(suite.T(), "# 🐛 heading\n; Please enter the commit message :rocket:\n", RewriteMessage(message, ";", suite.processor.Process))
+
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{
+			"picked after a heading",
+			"# :bug: heading\n\n; Please enter the commit message :rocket:\n",
+			"# 🐛 heading\n\n; Please enter the commit message :rocket:\n",
+		},
+		{
+			"verbose",
+			"# :bug: fix\n; ------------------------ >8 ------------------------\n+ :rocket:\n",
+			"# 🐛 fix\n; ------------------------ >8 ------------------------\n+ :rocket:\n",
+		},
+		{
+			"no comments",
+			":sparkles: add\n\n:tada: body\n",
+			"✨ add\n\n🎉 body\n",
+		},
+		{
+			"default",
+			":bug: fix\n# :rocket:\n",
+			"🐛 fix\n# :rocket:\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, RewriteMessage(tt.message, AutoCommentChar, suite.processor.Process))
+		})
+	}
+}
+
+// TestUnknownAliases tests detection of misspelled aliases
+func (suite *MessageTestSuite) TestUnknownAliases() {
+	message := "fix :rocekt: at 12:30:45 :bug:\n# comment :not_an_alias:\n"
+	assert.Equal(suite.T(), []string{":rocekt:"}, UnknownAliases(message, DefaultCommentChar, suite.processor))
+	assert.Empty(suite.T(), UnknownAliases(verboseMessage, DefaultCommentChar, suite.processor))
+}
+
+// TestMessage runs all commit message tests
+func TestMessage(t *testing.T) {
+	suite.Run(t, new(MessageTestSuite))
+}
@@ -0,0 +1,87 @@
+package htmltext
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// excluded lists the elements whose content is never converted, because it is
+// code where an alias-like token such as "a ? b :c:" is meaningful
+var excluded = map[atom.Atom]bool{
+	atom.Code: true,
+	atom.Pre:  true,
+}
+
+// rawText lists the elements whose content is raw text, which the tokenizer
+// returns as a single text token up to the matching end tag and which is
+// never converted either
+var rawText = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Textarea: true,
+}
+
+// Transform applies convert to the text of an HTML document, outside tags and excluded elements
+//
+// Tags, attribute values, comments and the content of <script>, <style>,
+// <code>, <pre> and <textarea> are copied byte-for-byte, as is everything
+// convert leaves unchanged. Text is passed to convert as written, with its
+// character references still encoded. An end tag only closes an excluded
+// element of the same name, along with any excluded elements opened inside
+// it, so stray end tags are ignored and an unclosed <pre> or <code> excludes
+// the rest of the document.
+func Transform(document string, convert func(string) string) string {
+	var result strings.Builder
+	result.Grow(len(document))
+
+	tokenizer := html.NewTokenizer(strings.NewReader(document))
+	consumed := 0        // bytes of document already tokenized
+	var open []atom.Atom // excluded elements not yet closed, innermost last
+	inRawText := false   // the next token is the content of a raw text element
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			// The tokenizer drops an unterminated tag or comment at the end of the input
+			result.WriteString(document[consumed:])
+			return result.String()
+		}
+
+		raw := tokenizer.Raw()
+		consumed += len(raw)
+
+		if tokenType == html.TextToken && !inRawText && len(open) == 0 {
+			result.WriteString(convert(string(raw)))
+			continue
+		}
+
+		inRawText = false
+
+		// Write the token before TagName, which lowercases the name in place
+		result.Write(raw)
+
+		switch tokenType {
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			element := atom.Lookup(name)
+
+			if rawText[element] {
+				inRawText = true
+			} else if excluded[element] {
+				open = append(open, element)
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			element := atom.Lookup(name)
+
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == element {
+					open = open[:i]
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package htmltext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// HTMLTextTestSuite defines the test suite for HTML-aware conversion
+type HTMLTextTestSuite struct {
+	suite.Suite
+	processor *emojify.Processor
+}
+
+// SetupTest creates a processor for each test
+func (suite *HTMLTextTestSuite) SetupTest() {
+	suite.processor = emojify.NewProcessor()
+}
+
+// TestTransform tests that only text outside tags and excluded elements is converted
+func (suite *HTMLTextTestSuite) TestTransform() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "text nodes",
+			input:    "<p>Ship :rocket:</p>\n<ul><li>:tada:</li></ul>",
+			expected: "<p>Ship 🚀</p>\n<ul><li>🎉</li></ul>",
+		},
+		{
+			name:     "attributes",
+			input:    `<a title=":rocket:" href="/:bug:">:bug:</a>`,
+			expected: `<a title=":rocket:" href="/:bug:">🐛</a>`,
+		},
+		{
+			name:     "script and style",
+			input:    "<script>x = a ? b :c:;</script><style>a:hover:not(.x) {}</style>:c:",
+			expected: "<script>x = a ? b :c:;</script><style>a:hover:not(.x) {}</style>:c:",
+		},
+		{
+			name:     "code, pre and textarea",
+			input:    "<pre>:bug:</pre><code>:bug:</code><textarea>:bug:</textarea>:bug:",
+			expected: "<pre>:bug:</pre><code>:bug:</code><textarea>:bug:</textarea>🐛",
+		},
+		{
+			name:     "nested elements inside excluded elements",
+			input:    "<pre><code><b>:bug:</b></code> :bug:</pre> :bug:",
+			expected: "<pre><code><b>:bug:</b></code> :bug:</pre> 🐛",
+		},
+		{
+			name:     "comments",
+			input:    "<!-- :bug: --> :bug:",
+			expected: "<!-- :bug: --> 🐛",
+		},
+		{
+			name:     "entities are preserved",
+			input:    "<p>Tom &amp; Jerry&nbsp;:heart: &lt;3</p>",
+			expected: "<p>Tom &amp; Jerry&nbsp;❤️ &lt;3</p>",
+		},
+		{
+			name:     "uppercase tags",
+			input:    "<PRE>:bug:</PRE>:bug:",
+			expected: "<PRE>:bug:</PRE>🐛",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, Transform(tt.input, suite.processor.Process))
+		})
+	}
+}
+
+// TestMalformed tests that stray and misnested tags don't change which text is converted
+func (suite *HTMLTextTestSuite) TestMalformed() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "stray end tag",
+			input:    "</code>:bug: <code>:bug:</code> :bug:",
+			expected: "</code>🐛 <code>:bug:</code> 🐛",
+		},
+		{
+			name:     "end tag of another excluded element",
+			input:    "<pre></code>:bug:</pre> :bug:",
+			expected: "<pre></code>:bug:</pre> 🐛",
+		},
+		{
+			name:     "misnested elements",
+			input:    "<pre><code>:bug:</pre> :bug:",
+			expected: "<pre><code>:bug:</pre> 🐛",
+		},
+		{
+			name:     "repeated start tags",
+			input:    "<code><code>:bug:</code> :bug:</code> :bug:",
+			expected: "<code><code>:bug:</code> :bug:</code> 🐛",
+		},
+		{
+			name:     "unclosed element",
+			input:    "<p>:bug:</p><pre>:bug: <p>:bug:</p>",
+			expected: "<p>🐛</p><pre>:bug: <p>:bug:</p>",
+		},
+		{
+			name:     "tags inside textarea",
+			input:    "<textarea></code><pre>:bug:</textarea> :bug:",
+			expected: "<textarea></code><pre>:bug:</textarea> 🐛",
+		},
+		{
+			name:     "tags inside script and style",
+			input:    "<script>'<pre>'</script><style>/* </code> */</style> :bug:",
+			expected: "<script>'<pre>'</script><style>/* </code> */</style> 🐛",
+		},
+		{
+			name:     "empty raw text element",
+			input:    "<textarea></textarea>:bug:",
+			expected: "<textarea></textarea>🐛",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.expected, Transform(tt.input, suite.processor.Process))
+		})
+	}
+}
+
+// TestTransformDecode tests converting emoji in text nodes back to aliases
+func (suite *HTMLTextTestSuite) TestTransformDecode() {
+	result := Transform(`<p title="🚀">🚀</p><code>🚀</code>`, suite.processor.Decode)
+	assert.Equal(suite.T(), `<p title="🚀">:rocket:</p><code>🚀</code>`, result)
+}
+
+// TestBytePreservation tests that documents are reproduced exactly when nothing is converted
+func (suite *HTMLTextTestSuite) TestBytePreservation() {
+	documents := []string{
+		"",
+		"plain text",
+		"<!DOCTYPE html>\r\n<html lang=en>\r\n<head><meta charset=utf-8></head>\r\n<body class='x'  id = y>\n</body></html>\n",
+		"<p>unclosed <b>tags",
+		"<br/><img src=x alt=\"a > b\"><input disabled>",
+		"a < b && c > d",
+		"<p title=\"unterminated",
+		"<!-- unterminated comment",
+		"<![CDATA[ :x: ]]>",
+		"</stray> end",
+	}
+
+	identity := func(text string) string { return text }
+
+	for _, document := range documents {
+		assert.Equal(suite.T(), document, Transform(document, identity), "Document %q", document)
+	}
+}
+
+// TestTextNodes tests that convert only sees text nodes
+func (suite *HTMLTextTestSuite) TestTextNodes() {
+	var seen []string
+	Transform(`<p class="a">one <b>two</b></p><script>three</script>four`, func(text string) string {
+		seen = append(seen, text)
+		return strings.ToUpper(text)
+	})
+
+	assert.Equal(suite.T(), []string{"one ", "two", "four"}, seen)
+}
+
+// TestHTMLText runs all HTML-aware conversion tests
+func TestHTMLText(t *testing.T) {
+	suite.Run(t, new(HTMLTextTestSuite))
+}
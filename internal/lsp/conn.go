@@ -0,0 +1,141 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// JSON-RPC error codes used by the server
+const (
+	codeParseError           = -32700
+	codeInvalidRequest       = -32600
+	codeMethodNotFound       = -32601
+	codeInvalidParams        = -32602
+	codeInternalError        = -32603
+	codeServerNotInitialized = -32002
+)
+
+// maxMessageSize guards against clients announcing absurd Content-Length values
+const maxMessageSize = 64 << 20
+
+// message is an incoming JSON-RPC request, notification or response
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isRequest reports whether the message expects a response
+func (m *message) isRequest() bool {
+	return len(m.ID) > 0 && string(m.ID) != "null"
+}
+
+// responseError is the error object of a failed JSON-RPC request
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error
+func (e *responseError) Error() string {
+	return e.Message
+}
+
+// conn reads and writes LSP base protocol messages: a Content-Length header and a JSON body
+type conn struct {
+	reader *textproto.Reader
+	body   *bufio.Reader
+	writer io.Writer
+	mu     sync.Mutex
+}
+
+// newConn creates a connection over a reader and writer, typically stdin and stdout
+func newConn(r io.Reader, w io.Writer) *conn {
+	reader := bufio.NewReader(r)
+	return &conn{reader: textproto.NewReader(reader), body: reader, writer: w}
+}
+
+// read returns the next message, or io.EOF when the input is closed
+func (c *conn) read() (*message, []byte, error) {
+	header, err := c.reader.ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil, io.EOF
+		}
+
+		return nil, nil, fmt.Errorf("failed to read message header: %w", err)
+	}
+
+	length, err := strconv.Atoi(strings.TrimSpace(header.Get("Content-Length")))
+	if err != nil || length < 0 || length > maxMessageSize {
+		return nil, nil, fmt.Errorf("invalid Content-Length %q", header.Get("Content-Length"))
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(c.body, body); err != nil {
+		return nil, nil, fmt.Errorf("failed to read message body: %w", err)
+	}
+
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, body, err
+	}
+
+	return &msg, body, nil
+}
+
+// write sends a message with its Content-Length header
+func (c *conn) write(value any) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := fmt.Fprintf(c.writer, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+
+	_, err = c.writer.Write(body)
+	return err
+}
+
+// reply sends the result of a request
+func (c *conn) reply(id json.RawMessage, result any) error {
+	return c.write(struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  any             `json:"result"`
+	}{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+// replyError sends an error response to a request
+func (c *conn) replyError(id json.RawMessage, err *responseError) error {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return c.write(struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Error   *responseError  `json:"error"`
+	}{JSONRPC: "2.0", ID: id, Error: err})
+}
+
+// notify sends a notification to the client
+func (c *conn) notify(method string, params any) error {
+	return c.write(struct {
+		JSONRPC string `json:"jsonrpc"`
+		Method  string `json:"method"`
+		Params  any    `json:"params"`
+	}{JSONRPC: "2.0", Method: method, Params: params})
+}
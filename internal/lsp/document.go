@@ -0,0 +1,99 @@
+package lsp
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// document is an open text document and its line index
+type document struct {
+	text       string
+	lineStarts []int
+	// utf16 is true when positions count UTF-16 code units, the LSP default
+	utf16 bool
+}
+
+// newDocument indexes the lines of text
+func newDocument(text string, utf16 bool) *document {
+	lineStarts := []int{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	return &document{text: text, lineStarts: lineStarts, utf16: utf16}
+}
+
+// line returns the text of a line without its line ending, and the offset where it starts
+func (d *document) line(index int) (string, int) {
+	if index < 0 || index >= len(d.lineStarts) {
+		return "", len(d.text)
+	}
+
+	start := d.lineStarts[index]
+	end := len(d.text)
+	if index+1 < len(d.lineStarts) {
+		end = d.lineStarts[index+1]
+	}
+
+	return strings.TrimRight(d.text[start:end], "\r\n"), start
+}
+
+// offset converts a position to a byte offset, clamping it to the document
+func (d *document) offset(position Position) int {
+	if position.Line >= len(d.lineStarts) {
+		return len(d.text)
+	}
+
+	line, start := d.line(max(position.Line, 0))
+	if !d.utf16 {
+		return start + min(max(position.Character, 0), len(line))
+	}
+
+	// A position inside a surrogate pair resolves to the start of its character
+	units := 0
+	for i, char := range line {
+		units += utf16Length(char)
+		if units > position.Character {
+			return start + i
+		}
+	}
+
+	return start + len(line)
+}
+
+// position converts a byte offset to a position
+func (d *document) position(offset int) Position {
+	offset = min(max(offset, 0), len(d.text))
+
+	// Find the last line starting at or before offset
+	line := sort.SearchInts(d.lineStarts, offset+1) - 1
+
+	prefix := d.text[d.lineStarts[line]:offset]
+	if !d.utf16 {
+		return Position{Line: line, Character: len(prefix)}
+	}
+
+	units := 0
+	for _, char := range prefix {
+		units += utf16Length(char)
+	}
+
+	return Position{Line: line, Character: units}
+}
+
+// rangeOf converts a byte range to an LSP range
+func (d *document) rangeOf(start, end int) Range {
+	return Range{Start: d.position(start), End: d.position(end)}
+}
+
+// utf16Length returns the number of UTF-16 code units needed for a character
+func utf16Length(char rune) int {
+	if char >= 0x10000 && char <= utf8.MaxRune {
+		return 2
+	}
+
+	return 1
+}
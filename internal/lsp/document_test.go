@@ -0,0 +1,69 @@
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// DocumentTestSuite defines the test suite for position conversion
+type DocumentTestSuite struct {
+	suite.Suite
+}
+
+// TestUTF16Positions tests conversion with the default UTF-16 encoding
+func (suite *DocumentTestSuite) TestUTF16Positions() {
+	doc := newDocument("a🚀b\r\nsecond\n", true)
+
+	tests := []struct {
+		position Position
+		offset   int
+	}{
+		{position: Position{Line: 0, Character: 0}, offset: 0},
+		{position: Position{Line: 0, Character: 1}, offset: 1},
+		{position: Position{Line: 0, Character: 3}, offset: 5},
+		{position: Position{Line: 0, Character: 4}, offset: 6},
+		{position: Position{Line: 1, Character: 2}, offset: 10},
+		{position: Position{Line: 2, Character: 0}, offset: 15},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(suite.T(), tt.offset, doc.offset(tt.position), "Position %+v", tt.position)
+		assert.Equal(suite.T(), tt.position, doc.position(tt.offset), "Offset %d", tt.offset)
+	}
+
+	// Inside a surrogate pair resolves to the start of the character
+	assert.Equal(suite.T(), 1, doc.offset(Position{Line: 0, Character: 2}))
+
+	// Out of range positions are clamped
+	assert.Equal(suite.T(), 6, doc.offset(Position{Line: 0, Character: 99}))
+	assert.Equal(suite.T(), 15, doc.offset(Position{Line: 9, Character: 0}))
+}
+
+// TestUTF8Positions tests conversion when the client negotiates UTF-8
+func (suite *DocumentTestSuite) TestUTF8Positions() {
+	doc := newDocument("a🚀b\nc", false)
+
+	assert.Equal(suite.T(), 5, doc.offset(Position{Line: 0, Character: 5}))
+	assert.Equal(suite.T(), Position{Line: 0, Character: 5}, doc.position(5))
+	assert.Equal(suite.T(), Position{Line: 1, Character: 1}, doc.position(8))
+}
+
+// TestLine tests line extraction without line endings
+func (suite *DocumentTestSuite) TestLine() {
+	doc := newDocument("one\r\ntwo", true)
+
+	line, start := doc.line(0)
+	assert.Equal(suite.T(), "one", line)
+	assert.Equal(suite.T(), 0, start)
+
+	line, start = doc.line(1)
+	assert.Equal(suite.T(), "two", line)
+	assert.Equal(suite.T(), 5, start)
+}
+
+// TestDocument runs all document tests
+func TestDocument(t *testing.T) {
+	suite.Run(t, new(DocumentTestSuite))
+}
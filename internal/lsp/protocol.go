@@ -0,0 +1,188 @@
+package lsp
+
+import "encoding/json"
+
+// The subset of the Language Server Protocol 3.17 used by the server
+
+// Position is a zero-based line and character offset in a document
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range is a half-open range between two positions
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// TextDocumentIdentifier names a document by URI
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// TextDocumentItem is a document sent by textDocument/didOpen
+type TextDocumentItem struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version"`
+	Text    string `json:"text"`
+}
+
+// TextEdit replaces a range of a document
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"newText"`
+}
+
+// WorkspaceEdit groups text edits by document URI
+type WorkspaceEdit struct {
+	Changes map[string][]TextEdit `json:"changes"`
+}
+
+// MarkupContent is formatted documentation
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// InitializeParams are the parameters of the initialize request
+type InitializeParams struct {
+	Capabilities struct {
+		General struct {
+			PositionEncodings []string `json:"positionEncodings"`
+		} `json:"general"`
+	} `json:"capabilities"`
+	InitializationOptions struct {
+		// CompletionInsert chooses what completion inserts: "alias" (default) or "emoji"
+		CompletionInsert string `json:"completionInsert"`
+	} `json:"initializationOptions"`
+}
+
+// InitializeResult is the response to the initialize request
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+	ServerInfo   ServerInfo         `json:"serverInfo"`
+}
+
+// ServerInfo identifies the server to the client
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// ServerCapabilities lists the features the server supports
+type ServerCapabilities struct {
+	PositionEncoding   string            `json:"positionEncoding"`
+	TextDocumentSync   int               `json:"textDocumentSync"`
+	CompletionProvider CompletionOptions `json:"completionProvider"`
+	HoverProvider      bool              `json:"hoverProvider"`
+	CodeActionProvider CodeActionOptions `json:"codeActionProvider"`
+}
+
+// CompletionOptions configures completion
+type CompletionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters"`
+}
+
+// CodeActionOptions configures code actions
+type CodeActionOptions struct {
+	CodeActionKinds []string `json:"codeActionKinds"`
+}
+
+// DidOpenTextDocumentParams are the parameters of textDocument/didOpen
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// DidChangeTextDocumentParams are the parameters of textDocument/didChange
+type DidChangeTextDocumentParams struct {
+	TextDocument   TextDocumentIdentifier `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+// DidCloseTextDocumentParams are the parameters of textDocument/didClose
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// TextDocumentPositionParams identify a position in a document
+type TextDocumentPositionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+// CompletionList is the response to textDocument/completion
+type CompletionList struct {
+	IsIncomplete bool             `json:"isIncomplete"`
+	Items        []CompletionItem `json:"items"`
+}
+
+// CompletionItem is a single completion suggestion
+type CompletionItem struct {
+	Label         string         `json:"label"`
+	Kind          int            `json:"kind,omitempty"`
+	Detail        string         `json:"detail,omitempty"`
+	Documentation *MarkupContent `json:"documentation,omitempty"`
+	SortText      string         `json:"sortText,omitempty"`
+	FilterText    string         `json:"filterText,omitempty"`
+	TextEdit      *TextEdit      `json:"textEdit,omitempty"`
+}
+
+// Hover is the response to textDocument/hover
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+// Diagnostic reports a problem in a document
+type Diagnostic struct {
+	Range    Range           `json:"range"`
+	Severity int             `json:"severity"`
+	Source   string          `json:"source"`
+	Code     string          `json:"code,omitempty"`
+	Message  string          `json:"message"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// PublishDiagnosticsParams are the parameters of textDocument/publishDiagnostics
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// CodeActionParams are the parameters of textDocument/codeAction
+type CodeActionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Range        Range                  `json:"range"`
+	Context      struct {
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	} `json:"context"`
+}
+
+// CodeAction is an edit offered to the user
+type CodeAction struct {
+	Title       string        `json:"title"`
+	Kind        string        `json:"kind"`
+	Diagnostics []Diagnostic  `json:"diagnostics,omitempty"`
+	IsPreferred bool          `json:"isPreferred,omitempty"`
+	Edit        WorkspaceEdit `json:"edit"`
+}
+
+// Protocol constants
+const (
+	positionEncodingUTF8  = "utf-8"
+	positionEncodingUTF16 = "utf-16"
+
+	textDocumentSyncFull = 1
+
+	completionItemKindText = 1
+
+	diagnosticSeverityWarning = 2
+
+	markupKindMarkdown = "markdown"
+
+	codeActionKindQuickFix        = "quickfix"
+	codeActionKindRefactorRewrite = "refactor.rewrite"
+)
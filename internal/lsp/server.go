@@ -0,0 +1,523 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+const (
+	// serverName identifies the server in the initialize response and diagnostics
+	serverName = "emojify"
+
+	// maxCompletionItems limits the number of completion items returned at once
+	maxCompletionItems = 100
+
+	// unknownAliasCode is the diagnostic code for unknown aliases
+	unknownAliasCode = "unknown-alias"
+)
+
+// ErrExitWithoutShutdown is returned by Run when the client exits without a shutdown request
+var ErrExitWithoutShutdown = errors.New("client exited without shutdown")
+
+// Server is a language server for emoji aliases
+//
+// It completes aliases after ":", describes the alias or emoji under the
+// cursor on hover, reports unknown aliases as diagnostics and offers code
+// actions that convert between aliases and emoji.
+type Server struct {
+	processor *emojify.Processor
+	version   string
+
+	conn        *conn
+	documents   map[string]*document
+	utf16       bool
+	insertEmoji bool
+	initialized bool
+	shutdown    bool
+}
+
+// unknownAliasData is attached to unknown alias diagnostics for the quick fix
+type unknownAliasData struct {
+	Suggestion string `json:"suggestion"`
+}
+
+// NewServer creates a language server that converts text with the given processor
+func NewServer(processor *emojify.Processor, version string) *Server {
+	return &Server{
+		processor: processor,
+		version:   version,
+		documents: make(map[string]*document),
+		utf16:     true,
+	}
+}
+
+// Run serves requests read from r, writing responses to w, until the client exits
+func (s *Server) Run(r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+
+	for {
+		msg, body, err := s.conn.read()
+		if errors.Is(err, io.EOF) {
+			if s.shutdown {
+				return nil
+			}
+
+			return ErrExitWithoutShutdown
+		}
+
+		if err != nil {
+			if body == nil {
+				return err
+			}
+
+			// The message was framed correctly but is not valid JSON
+			if err := s.conn.replyError(nil, &responseError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if msg.Method == "exit" {
+			if s.shutdown {
+				return nil
+			}
+
+			return ErrExitWithoutShutdown
+		}
+
+		result, rpcErr := s.handle(msg)
+		if !msg.isRequest() {
+			continue
+		}
+
+		if rpcErr != nil {
+			err = s.conn.replyError(msg.ID, rpcErr)
+		} else {
+			err = s.conn.reply(msg.ID, result)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches a request or notification to its handler
+func (s *Server) handle(msg *message) (any, *responseError) {
+	if msg.Method == "" {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "missing method"}
+	}
+
+	if !s.initialized && msg.Method != "initialize" {
+		return nil, &responseError{Code: codeServerNotInitialized, Message: "server not initialized"}
+	}
+
+	switch msg.Method {
+	case "initialize":
+		var params InitializeParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		return s.initialize(params), nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		return nil, s.open(params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+
+		// Full sync: the last change holds the whole document
+		return nil, s.open(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		delete(s.documents, params.TextDocument.URI)
+
+		return nil, s.publishDiagnostics(params.TextDocument.URI, []Diagnostic{})
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		return s.completion(params), nil
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		return s.hover(params), nil
+	case "textDocument/codeAction":
+		var params CodeActionParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		return s.codeActions(params), nil
+	default:
+		return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", msg.Method)}
+	}
+}
+
+// decodeParams unmarshals the parameters of a message
+func decodeParams(msg *message, params any) *responseError {
+	if len(msg.Params) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	return nil
+}
+
+// initialize negotiates capabilities with the client
+func (s *Server) initialize(params InitializeParams) InitializeResult {
+	s.initialized = true
+	s.utf16 = !slices.Contains(params.Capabilities.General.PositionEncodings, positionEncodingUTF8)
+	s.insertEmoji = params.InitializationOptions.CompletionInsert == "emoji"
+
+	encoding := positionEncodingUTF16
+	if !s.utf16 {
+		encoding = positionEncodingUTF8
+	}
+
+	return InitializeResult{
+		Capabilities: ServerCapabilities{
+			PositionEncoding:   encoding,
+			TextDocumentSync:   textDocumentSyncFull,
+			CompletionProvider: CompletionOptions{TriggerCharacters: []string{":"}},
+			HoverProvider:      true,
+			CodeActionProvider: CodeActionOptions{
+				CodeActionKinds: []string{codeActionKindQuickFix, codeActionKindRefactorRewrite},
+			},
+		},
+		ServerInfo: ServerInfo{Name: serverName, Version: s.version},
+	}
+}
+
+// open stores the text of a document and publishes its diagnostics
+func (s *Server) open(uri, text string) *responseError {
+	doc := newDocument(text, s.utf16)
+	s.documents[uri] = doc
+
+	return s.publishDiagnostics(uri, s.diagnostics(doc))
+}
+
+// publishDiagnostics sends the diagnostics for a document to the client
+func (s *Server) publishDiagnostics(uri string, diagnostics []Diagnostic) *responseError {
+	if err := s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: uri, Diagnostics: diagnostics}); err != nil {
+		return &responseError{Code: codeInternalError, Message: err.Error()}
+	}
+
+	return nil
+}
+
+// diagnostics reports every unknown alias in a document
+//
+// Tokens without letters, such as the ":30:" in "12:30:45", are not reported.
+func (s *Server) diagnostics(doc *document) []Diagnostic {
+	diagnostics := []Diagnostic{}
+
+	for _, segment := range s.processor.Segments(doc.text) {
+		if !segment.IsUnknownAlias() {
+			continue
+		}
+
+		diagnostic := Diagnostic{
+			Range:    doc.rangeOf(segment.Offset, segment.Offset+len(segment.Alias)),
+			Severity: diagnosticSeverityWarning,
+			Source:   serverName,
+			Code:     unknownAliasCode,
+			Message:  fmt.Sprintf("unknown emoji alias %s", segment.Alias),
+		}
+
+		if suggestion, ok := emoji.ClosestAlias(segment.Alias); ok {
+			diagnostic.Message += fmt.Sprintf(" (did you mean %s?)", suggestion)
+			diagnostic.Data, _ = json.Marshal(unknownAliasData{Suggestion: suggestion})
+		}
+
+		diagnostics = append(diagnostics, diagnostic)
+	}
+
+	return diagnostics
+}
+
+// completion suggests aliases for the partial alias before the cursor
+func (s *Server) completion(params TextDocumentPositionParams) CompletionList {
+	list := CompletionList{Items: []CompletionItem{}}
+
+	doc, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return list
+	}
+
+	offset := doc.offset(params.Position)
+	line, lineStart := doc.line(params.Position.Line)
+	column := offset - lineStart
+
+	// Walk back over the partial alias to its opening colon
+	start := column
+	for start > 0 {
+		char, size := utf8.DecodeLastRuneInString(line[:start])
+		if !emoji.IsValidEmojiChar(char) {
+			break
+		}
+
+		start -= size
+	}
+
+	if start == 0 || line[start-1] != ':' {
+		return list
+	}
+
+	colon := start - 1
+
+	// A colon straight after a word, as in "http:" or "Note:", doesn't start an alias
+	if before, _ := utf8.DecodeLastRuneInString(line[:colon]); colon > 0 && (unicode.IsLetter(before) || unicode.IsDigit(before)) {
+		return list
+	}
+
+	var results []emoji.Info
+	prefix := line[start:column]
+	if prefix == "" {
+		for _, alias := range emoji.Aliases()[:maxCompletionItems] {
+			if info, ok := emoji.Lookup(alias); ok {
+				results = append(results, info)
+			}
+		}
+
+		list.IsIncomplete = true
+	} else {
+		results = emoji.Search(prefix, maxCompletionItems)
+		list.IsIncomplete = len(results) == maxCompletionItems
+	}
+
+	editRange := doc.rangeOf(lineStart+colon, offset)
+	for i, info := range results {
+		newText := info.Alias
+		if s.insertEmoji {
+			newText = info.Emoji
+		}
+
+		list.Items = append(list.Items, CompletionItem{
+			Label:         info.Alias,
+			Kind:          completionItemKindText,
+			Detail:        strings.TrimSpace(info.Emoji + " " + info.Name),
+			Documentation: &MarkupContent{Kind: markupKindMarkdown, Value: describe(info)},
+			SortText:      fmt.Sprintf("%04d", i),
+			FilterText:    info.Alias,
+			TextEdit:      &TextEdit{Range: editRange, NewText: newText},
+		})
+	}
+
+	return list
+}
+
+// hover describes the alias or emoji under the cursor
+func (s *Server) hover(params TextDocumentPositionParams) *Hover {
+	doc, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+
+	found, ok := s.findAt(doc, doc.offset(params.Position))
+	if !ok {
+		return nil
+	}
+
+	info, ok := emoji.Lookup(found.emoji)
+	if !ok {
+		return nil
+	}
+
+	if found.isAlias {
+		info.Alias = found.alias
+	}
+
+	hoverRange := doc.rangeOf(found.start, found.end)
+
+	return &Hover{
+		Contents: MarkupContent{Kind: markupKindMarkdown, Value: describe(info)},
+		Range:    &hoverRange,
+	}
+}
+
+// codeActions offers quick fixes for unknown aliases and conversions of the selection
+func (s *Server) codeActions(params CodeActionParams) []CodeAction {
+	actions := []CodeAction{}
+
+	uri := params.TextDocument.URI
+	doc, ok := s.documents[uri]
+	if !ok {
+		return actions
+	}
+
+	for _, diagnostic := range params.Context.Diagnostics {
+		var data unknownAliasData
+		if diagnostic.Source != serverName || diagnostic.Code != unknownAliasCode || json.Unmarshal(diagnostic.Data, &data) != nil || data.Suggestion == "" {
+			continue
+		}
+
+		actions = append(actions, CodeAction{
+			Title:       fmt.Sprintf("Replace with %s %s", data.Suggestion, emoji.GetEmoji(data.Suggestion)),
+			Kind:        codeActionKindQuickFix,
+			Diagnostics: []Diagnostic{diagnostic},
+			IsPreferred: true,
+			Edit:        singleEdit(uri, diagnostic.Range, data.Suggestion),
+		})
+	}
+
+	start, end := doc.offset(params.Range.Start), doc.offset(params.Range.End)
+	if start < end {
+		selected := doc.text[start:end]
+		selection := doc.rangeOf(start, end)
+
+		if encoded := s.processor.Process(selected); encoded != selected {
+			actions = append(actions, CodeAction{
+				Title: "Convert aliases to emoji",
+				Kind:  codeActionKindRefactorRewrite,
+				Edit:  singleEdit(uri, selection, encoded),
+			})
+		}
+
+		if decoded := s.processor.Decode(selected); decoded != selected {
+			actions = append(actions, CodeAction{
+				Title: "Convert emoji to aliases",
+				Kind:  codeActionKindRefactorRewrite,
+				Edit:  singleEdit(uri, selection, decoded),
+			})
+		}
+
+		return actions
+	}
+
+	// Without a selection, convert the alias or emoji under the cursor
+	if found, ok := s.findAt(doc, start); ok {
+		title, newText := fmt.Sprintf("Convert %s to %s", found.emoji, found.alias), found.alias
+		if found.isAlias {
+			title, newText = fmt.Sprintf("Convert %s to %s", found.alias, found.emoji), found.emoji
+		}
+
+		actions = append(actions, CodeAction{
+			Title: title,
+			Kind:  codeActionKindRefactorRewrite,
+			Edit:  singleEdit(uri, doc.rangeOf(found.start, found.end), newText),
+		})
+	}
+
+	return actions
+}
+
+// found is an alias or emoji located in a document
+type found struct {
+	start, end int
+	alias      string
+	emoji      string
+	// isAlias is true when the document contains the alias rather than the emoji
+	isAlias bool
+}
+
+// findAt returns the alias or emoji containing the byte offset
+func (s *Server) findAt(doc *document, offset int) (found, bool) {
+	line, lineStart := doc.line(doc.position(offset).Line)
+	column := offset - lineStart
+
+	for _, segment := range s.processor.Segments(line) {
+		end := segment.Offset + len(segment.Source)
+		if segment.Kind == emojify.SegmentEmoji && segment.Offset <= column && column < end {
+			return found{
+				start:   lineStart + segment.Offset,
+				end:     lineStart + end,
+				alias:   segment.Alias,
+				emoji:   segment.Text,
+				isAlias: true,
+			}, true
+		}
+	}
+
+	for i := 0; i < len(line) && i <= column; {
+		if matched, alias, ok := emoji.MatchEmoji(line[i:]); ok {
+			if column < i+len(matched) {
+				return found{start: lineStart + i, end: lineStart + i + len(matched), alias: alias, emoji: matched}, true
+			}
+
+			i += len(matched)
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(line[i:])
+		i += size
+	}
+
+	return found{}, false
+}
+
+// describe formats emoji information as Markdown
+func describe(info emoji.Info) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# %s\n\n**%s**", info.Emoji, info.Alias)
+	if info.Name != "" {
+		fmt.Fprintf(&builder, " — %s", info.Name)
+	}
+
+	builder.WriteString("\n\n")
+
+	if others := slices.DeleteFunc(slices.Clone(info.Aliases), func(alias string) bool { return alias == info.Alias }); len(others) > 0 {
+		fmt.Fprintf(&builder, "Also: `%s`\n\n", strings.Join(others, "`, `"))
+	}
+
+	if info.Gitmoji != "" {
+		fmt.Fprintf(&builder, "Gitmoji: %s\n\n", info.Gitmoji)
+	}
+
+	details := []string{info.Codepoints}
+	if info.UnicodeVersion != "" {
+		details = append(details, "Unicode "+info.UnicodeVersion)
+	}
+
+	if info.Group != "" {
+		details = append(details, info.Group+" › "+info.Subgroup)
+	}
+
+	builder.WriteString(strings.Join(details, " · "))
+
+	return builder.String()
+}
+
+// singleEdit builds a workspace edit replacing one range of a document
+func singleEdit(uri string, editRange Range, newText string) WorkspaceEdit {
+	return WorkspaceEdit{Changes: map[string][]TextEdit{uri: {{Range: editRange, NewText: newText}}}}
+}
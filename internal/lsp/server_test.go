@@ -0,0 +1,325 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+const testURI = "file:///notes.md"
+
+// ServerTestSuite defines the test suite for the language server
+type ServerTestSuite struct {
+	suite.Suite
+}
+
+// session is the output of a language server run
+type session struct {
+	responses     map[int]json.RawMessage
+	errors        map[int]responseError
+	notifications []message
+	err           error
+}
+
+// frame encodes a message with its Content-Length header
+func frame(value any) string {
+	body, _ := json.Marshal(value)
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+// request builds a JSON-RPC request
+func request(id int, method string, params any) map[string]any {
+	return map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+// notification builds a JSON-RPC notification
+func notification(method string, params any) map[string]any {
+	return map[string]any{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+// run initializes a server, opens a document with text, sends the messages and shuts down
+func (suite *ServerTestSuite) run(initialize map[string]any, text string, messages ...map[string]any) session {
+	var input strings.Builder
+	input.WriteString(frame(request(0, "initialize", initialize)))
+	input.WriteString(frame(notification("initialized", map[string]any{})))
+	input.WriteString(frame(notification("textDocument/didOpen", map[string]any{
+		"textDocument": map[string]any{"uri": testURI, "languageId": "markdown", "version": 1, "text": text},
+	})))
+
+	for _, msg := range messages {
+		input.WriteString(frame(msg))
+	}
+
+	input.WriteString(frame(request(999, "shutdown", nil)))
+	input.WriteString(frame(notification("exit", nil)))
+
+	var output bytes.Buffer
+	err := NewServer(emojify.NewProcessor(), "test").Run(strings.NewReader(input.String()), &output)
+
+	return suite.parse(&output, err)
+}
+
+// parse splits server output into responses and notifications
+func (suite *ServerTestSuite) parse(output io.Reader, err error) session {
+	result := session{responses: map[int]json.RawMessage{}, errors: map[int]responseError{}, err: err}
+	c := newConn(bufio.NewReader(output), io.Discard)
+
+	for {
+		_, body, readErr := c.read()
+		if readErr == io.EOF {
+			return result
+		}
+
+		require.NoError(suite.T(), readErr)
+
+		var msg struct {
+			ID     *int            `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+			Result json.RawMessage `json:"result"`
+			Error  *responseError  `json:"error"`
+		}
+		require.NoError(suite.T(), json.Unmarshal(body, &msg))
+
+		switch {
+		case msg.Method != "":
+			result.notifications = append(result.notifications, message{Method: msg.Method, Params: msg.Params})
+		case msg.Error != nil && msg.ID != nil:
+			result.errors[*msg.ID] = *msg.Error
+		case msg.ID != nil:
+			result.responses[*msg.ID] = msg.Result
+		}
+	}
+}
+
+// position builds textDocument position parameters
+func position(line, character int) map[string]any {
+	return map[string]any{
+		"textDocument": map[string]any{"uri": testURI},
+		"position":     map[string]any{"line": line, "character": character},
+	}
+}
+
+// diagnostics returns the most recent diagnostics published for the test document
+func (s session) diagnostics() []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, n := range s.notifications {
+		var params PublishDiagnosticsParams
+		if n.Method == "textDocument/publishDiagnostics" && json.Unmarshal(n.Params, &params) == nil && params.URI == testURI {
+			diagnostics = params.Diagnostics
+		}
+	}
+
+	return diagnostics
+}
+
+// TestInitialize tests capability negotiation
+func (suite *ServerTestSuite) TestInitialize() {
+	result := suite.run(map[string]any{}, "")
+	require.NoError(suite.T(), result.err)
+
+	var initialize InitializeResult
+	require.NoError(suite.T(), json.Unmarshal(result.responses[0], &initialize))
+	assert.Equal(suite.T(), positionEncodingUTF16, initialize.Capabilities.PositionEncoding)
+	assert.Equal(suite.T(), []string{":"}, initialize.Capabilities.CompletionProvider.TriggerCharacters)
+	assert.True(suite.T(), initialize.Capabilities.HoverProvider)
+	assert.Equal(suite.T(), "emojify", initialize.ServerInfo.Name)
+
+	result = suite.run(map[string]any{"capabilities": map[string]any{"general": map[string]any{"positionEncodings": []string{"utf-8", "utf-16"}}}}, "")
+	require.NoError(suite.T(), json.Unmarshal(result.responses[0], &initialize))
+	assert.Equal(suite.T(), positionEncodingUTF8, initialize.Capabilities.PositionEncoding)
+}
+
+// TestLifecycle tests request handling before initialize and exit without shutdown
+func (suite *ServerTestSuite) TestLifecycle() {
+	input := frame(request(1, "textDocument/hover", position(0, 0))) +
+		frame(request(2, "initialize", map[string]any{})) +
+		frame(request(3, "workspace/unknown", nil)) +
+		frame(notification("exit", nil))
+
+	var output bytes.Buffer
+	err := NewServer(emojify.NewProcessor(), "test").Run(strings.NewReader(input), &output)
+	assert.ErrorIs(suite.T(), err, ErrExitWithoutShutdown)
+
+	result := suite.parse(&output, err)
+	assert.Equal(suite.T(), codeServerNotInitialized, result.errors[1].Code)
+	assert.Contains(suite.T(), result.responses, 2)
+	assert.Equal(suite.T(), codeMethodNotFound, result.errors[3].Code)
+}
+
+// TestCompletion tests alias completion after a colon
+func (suite *ServerTestSuite) TestCompletion() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	text := "Deploy 🚀 :rock\nhttp:\n:"
+	result := suite.run(map[string]any{}, text,
+		request(1, "textDocument/completion", position(0, 14)),
+		request(2, "textDocument/completion", position(1, 5)),
+		request(3, "textDocument/completion", position(2, 1)),
+	)
+	require.NoError(suite.T(), result.err)
+
+	var list CompletionList
+	require.NoError(suite.T(), json.Unmarshal(result.responses[1], &list))
+	require.NotEmpty(suite.T(), list.Items)
+
+	first := list.Items[0]
+	assert.Equal(suite.T(), ":rock:", first.Label)
+	assert.Contains(suite.T(), first.Detail, "🪨")
+	assert.Equal(suite.T(), ":rock:", first.TextEdit.NewText)
+	// The edit replaces ":rock", counting the rocket as two UTF-16 code units
+	assert.Equal(suite.T(), Range{Start: Position{Line: 0, Character: 10}, End: Position{Line: 0, Character: 14}}, first.TextEdit.Range)
+
+	labels := make([]string, len(list.Items))
+	for i, item := range list.Items {
+		labels[i] = item.Label
+	}
+
+	assert.Contains(suite.T(), labels, ":rocket:")
+
+	require.NoError(suite.T(), json.Unmarshal(result.responses[2], &list))
+	assert.Empty(suite.T(), list.Items, "A colon after a word is not an alias")
+
+	require.NoError(suite.T(), json.Unmarshal(result.responses[3], &list))
+	assert.Len(suite.T(), list.Items, maxCompletionItems)
+	assert.True(suite.T(), list.IsIncomplete)
+}
+
+// TestCompletionInsertsEmoji tests the completionInsert initialization option
+func (suite *ServerTestSuite) TestCompletionInsertsEmoji() {
+	result := suite.run(map[string]any{"initializationOptions": map[string]any{"completionInsert": "emoji"}}, ":rocke",
+		request(1, "textDocument/completion", position(0, 6)),
+	)
+
+	var list CompletionList
+	require.NoError(suite.T(), json.Unmarshal(result.responses[1], &list))
+	require.NotEmpty(suite.T(), list.Items)
+	assert.Equal(suite.T(), "🚀", list.Items[0].TextEdit.NewText)
+}
+
+// TestHover tests hover details for aliases and emoji
+func (suite *ServerTestSuite) TestHover() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	result := suite.run(map[string]any{}, "Ship :rocket: and 🎉 now",
+		request(1, "textDocument/hover", position(0, 8)),
+		request(2, "textDocument/hover", position(0, 19)),
+		request(3, "textDocument/hover", position(0, 2)),
+	)
+	require.NoError(suite.T(), result.err)
+
+	var hover Hover
+	require.NoError(suite.T(), json.Unmarshal(result.responses[1], &hover))
+	assert.Contains(suite.T(), hover.Contents.Value, "🚀")
+	assert.Contains(suite.T(), hover.Contents.Value, "**:rocket:** — rocket")
+	assert.Equal(suite.T(), Range{Start: Position{Line: 0, Character: 5}, End: Position{Line: 0, Character: 13}}, *hover.Range)
+
+	// Position 19 is the second UTF-16 code unit of 🎉
+	require.NoError(suite.T(), json.Unmarshal(result.responses[2], &hover))
+	assert.Contains(suite.T(), hover.Contents.Value, "**:tada:**")
+	assert.Equal(suite.T(), Range{Start: Position{Line: 0, Character: 18}, End: Position{Line: 0, Character: 20}}, *hover.Range)
+
+	assert.JSONEq(suite.T(), "null", string(result.responses[3]))
+}
+
+// TestDiagnostics tests warnings for unknown aliases
+func (suite *ServerTestSuite) TestDiagnostics() {
+	result := suite.run(map[string]any{}, "Ship :rocekt: at 12:30:45 :+1:\n:nothing_like_this:")
+	require.NoError(suite.T(), result.err)
+
+	diagnostics := result.diagnostics()
+	require.Len(suite.T(), diagnostics, 2)
+
+	assert.Equal(suite.T(), Range{Start: Position{Line: 0, Character: 5}, End: Position{Line: 0, Character: 13}}, diagnostics[0].Range)
+	assert.Equal(suite.T(), diagnosticSeverityWarning, diagnostics[0].Severity)
+	assert.Equal(suite.T(), "unknown emoji alias :rocekt: (did you mean :rocket:?)", diagnostics[0].Message)
+	assert.JSONEq(suite.T(), `{"suggestion": ":rocket:"}`, string(diagnostics[0].Data))
+
+	assert.Equal(suite.T(), 1, diagnostics[1].Range.Start.Line)
+	assert.Empty(suite.T(), diagnostics[1].Data)
+}
+
+// TestDiagnosticsUpdateOnChange tests that edits and closing refresh diagnostics
+func (suite *ServerTestSuite) TestDiagnosticsUpdateOnChange() {
+	result := suite.run(map[string]any{}, ":rocekt:",
+		notification("textDocument/didChange", map[string]any{
+			"textDocument":   map[string]any{"uri": testURI, "version": 2},
+			"contentChanges": []map[string]any{{"text": ":rocket:"}},
+		}),
+	)
+	assert.Empty(suite.T(), result.diagnostics())
+
+	result = suite.run(map[string]any{}, ":rocekt:",
+		notification("textDocument/didClose", map[string]any{"textDocument": map[string]any{"uri": testURI}}),
+	)
+	assert.Empty(suite.T(), result.diagnostics())
+}
+
+// TestCodeActions tests conversions and quick fixes
+func (suite *ServerTestSuite) TestCodeActions() {
+	text := "Ship :rocket: 🎉 :rocekt:"
+	diagnostic := Diagnostic{
+		Range:    Range{Start: Position{Line: 0, Character: 17}, End: Position{Line: 0, Character: 25}},
+		Severity: diagnosticSeverityWarning,
+		Source:   serverName,
+		Code:     unknownAliasCode,
+		Data:     json.RawMessage(`{"suggestion":":rocket:"}`),
+	}
+
+	result := suite.run(map[string]any{}, text,
+		request(1, "textDocument/codeAction", map[string]any{
+			"textDocument": map[string]any{"uri": testURI},
+			"range":        Range{Start: Position{Line: 0, Character: 0}, End: Position{Line: 0, Character: 16}},
+			"context":      map[string]any{"diagnostics": []Diagnostic{}},
+		}),
+		request(2, "textDocument/codeAction", map[string]any{
+			"textDocument": map[string]any{"uri": testURI},
+			"range":        Range{Start: Position{Line: 0, Character: 20}, End: Position{Line: 0, Character: 20}},
+			"context":      map[string]any{"diagnostics": []Diagnostic{diagnostic}},
+		}),
+		request(3, "textDocument/codeAction", map[string]any{
+			"textDocument": map[string]any{"uri": testURI},
+			"range":        Range{Start: Position{Line: 0, Character: 7}, End: Position{Line: 0, Character: 7}},
+			"context":      map[string]any{"diagnostics": []Diagnostic{}},
+		}),
+	)
+	require.NoError(suite.T(), result.err)
+
+	var actions []CodeAction
+	require.NoError(suite.T(), json.Unmarshal(result.responses[1], &actions))
+	require.Len(suite.T(), actions, 2)
+	assert.Equal(suite.T(), "Convert aliases to emoji", actions[0].Title)
+	assert.Equal(suite.T(), "Ship 🚀 🎉", actions[0].Edit.Changes[testURI][0].NewText)
+	assert.Equal(suite.T(), "Convert emoji to aliases", actions[1].Title)
+	assert.Equal(suite.T(), "Ship :rocket: :tada:", actions[1].Edit.Changes[testURI][0].NewText)
+
+	require.NoError(suite.T(), json.Unmarshal(result.responses[2], &actions))
+	require.Len(suite.T(), actions, 1)
+	assert.Equal(suite.T(), codeActionKindQuickFix, actions[0].Kind)
+	assert.True(suite.T(), actions[0].IsPreferred)
+	assert.Equal(suite.T(), TextEdit{Range: diagnostic.Range, NewText: ":rocket:"}, actions[0].Edit.Changes[testURI][0])
+
+	require.NoError(suite.T(), json.Unmarshal(result.responses[3], &actions))
+	require.Len(suite.T(), actions, 1)
+	assert.Equal(suite.T(), "Convert :rocket: to 🚀", actions[0].Title)
+}
+
+// TestServer runs all language server tests
+func TestServer(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
@@ -0,0 +1,125 @@
+package pandoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// skipped lists the elements whose text is never converted
+//
+// None of them contain Str inlines, but skipping them makes it explicit that
+// code, raw output and math are left exactly as written.
+var skipped = map[string]bool{
+	"Code":      true,
+	"CodeBlock": true,
+	"RawInline": true,
+	"RawBlock":  true,
+	"Math":      true,
+}
+
+// Filter reads a pandoc JSON AST from r, applies convert to its text and writes the AST to w
+//
+// Only Str inlines are converted. Adjacent Str inlines are joined before
+// converting, since readers may split an alias such as ":rocket:" into
+// several of them; a run is replaced by a single Str only when its text changes.
+func Filter(r io.Reader, w io.Writer, convert func(string) string) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var document map[string]any
+	if err := decoder.Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("expected a pandoc JSON document on stdin")
+		}
+
+		return fmt.Errorf("failed to read pandoc JSON: %w", err)
+	}
+
+	if _, ok := document["pandoc-api-version"]; !ok {
+		return fmt.Errorf("input is not a pandoc JSON document (missing pandoc-api-version)")
+	}
+
+	walk(document, convert)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(document)
+}
+
+// walk converts the Str inlines in node and its children, returning the updated node
+func walk(node any, convert func(string) string) any {
+	switch value := node.(type) {
+	case map[string]any:
+		if t, _ := value["t"].(string); skipped[t] {
+			return value
+		}
+
+		for key, child := range value {
+			value[key] = walk(child, convert)
+		}
+
+		return value
+	case []any:
+		for i, child := range value {
+			value[i] = walk(child, convert)
+		}
+
+		return convertInlines(value, convert)
+	default:
+		return node
+	}
+}
+
+// convertInlines applies convert to each run of adjacent Str inlines in list
+func convertInlines(list []any, convert func(string) string) []any {
+	if !slices.ContainsFunc(list, func(node any) bool { _, ok := strText(node); return ok }) {
+		return list
+	}
+
+	result := make([]any, 0, len(list))
+
+	for i := 0; i < len(list); {
+		if _, ok := strText(list[i]); !ok {
+			result = append(result, list[i])
+			i++
+			continue
+		}
+
+		var text strings.Builder
+		end := i
+		for ; end < len(list); end++ {
+			s, ok := strText(list[end])
+			if !ok {
+				break
+			}
+
+			text.WriteString(s)
+		}
+
+		if converted := convert(text.String()); converted != text.String() {
+			result = append(result, map[string]any{"t": "Str", "c": converted})
+		} else {
+			result = append(result, list[i:end]...)
+		}
+
+		i = end
+	}
+
+	return result
+}
+
+// strText returns the text of a Str inline
+func strText(node any) (string, bool) {
+	element, ok := node.(map[string]any)
+	if !ok || element["t"] != "Str" {
+		return "", false
+	}
+
+	text, ok := element["c"].(string)
+	return text, ok
+}
@@ -0,0 +1,120 @@
+package pandoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// FilterTestSuite defines the test suite for the pandoc filter
+type FilterTestSuite struct {
+	suite.Suite
+	processor *emojify.Processor
+}
+
+// SetupTest creates a processor for each test
+func (suite *FilterTestSuite) SetupTest() {
+	suite.processor = emojify.NewProcessor()
+}
+
+// filter runs the filter over a document with the given blocks and returns the resulting blocks as JSON
+func (suite *FilterTestSuite) filter(blocks string, convert func(string) string) string {
+	input := `{"pandoc-api-version":[1,23,1],"meta":{},"blocks":` + blocks + `}`
+
+	var output bytes.Buffer
+	require.NoError(suite.T(), Filter(strings.NewReader(input), &output, convert))
+
+	var document struct {
+		Blocks json.RawMessage `json:"blocks"`
+	}
+	require.NoError(suite.T(), json.Unmarshal(output.Bytes(), &document))
+
+	return string(document.Blocks)
+}
+
+// TestEncode tests converting aliases in Str inlines
+func (suite *FilterTestSuite) TestEncode() {
+	tests := []struct {
+		name     string
+		blocks   string
+		expected string
+	}{
+		{
+			name:     "single Str",
+			blocks:   `[{"t":"Para","c":[{"t":"Str","c":"Ship"},{"t":"Space"},{"t":"Str","c":":rocket:"}]}]`,
+			expected: `[{"c":[{"c":"Ship","t":"Str"},{"t":"Space"},{"c":"🚀","t":"Str"}],"t":"Para"}]`,
+		},
+		{
+			name:     "alias split across Str inlines",
+			blocks:   `[{"t":"Para","c":[{"t":"Str","c":":"},{"t":"Str","c":"rocket"},{"t":"Str","c":":!"}]}]`,
+			expected: `[{"c":[{"c":"🚀!","t":"Str"}],"t":"Para"}]`,
+		},
+		{
+			name:     "unchanged runs keep their inlines",
+			blocks:   `[{"t":"Para","c":[{"t":"Str","c":"10"},{"t":"Str","c":":30"}]}]`,
+			expected: `[{"c":[{"c":"10","t":"Str"},{"c":":30","t":"Str"}],"t":"Para"}]`,
+		},
+		{
+			name:     "nested inlines",
+			blocks:   `[{"t":"Header","c":[1,["intro",[],[]],[{"t":"Emph","c":[{"t":"Str","c":":tada:"}]}]]}]`,
+			expected: `[{"c":[1,["intro",[],[]],[{"c":[{"c":"🎉","t":"Str"}],"t":"Emph"}]],"t":"Header"}]`,
+		},
+		{
+			name:     "code and raw content untouched",
+			blocks:   `[{"t":"CodeBlock","c":[["",[],[]],":rocket:"]},{"t":"RawBlock","c":["html","<p>:rocket:</p>"]},{"t":"Para","c":[{"t":"Code","c":[["",[],[]],":bug:"]},{"t":"RawInline","c":["html",":bug:"]}]}]`,
+			expected: `[{"c":[["",[],[]],":rocket:"],"t":"CodeBlock"},{"c":["html","<p>:rocket:</p>"],"t":"RawBlock"},{"c":[{"c":[["",[],[]],":bug:"],"t":"Code"},{"c":["html",":bug:"],"t":"RawInline"}],"t":"Para"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.JSONEq(suite.T(), tt.expected, suite.filter(tt.blocks, suite.processor.Process))
+		})
+	}
+}
+
+// TestDecode tests converting emoji in Str inlines back to aliases
+func (suite *FilterTestSuite) TestDecode() {
+	result := suite.filter(`[{"t":"Plain","c":[{"t":"Str","c":"🚀"},{"t":"Code","c":[["",[],[]],"🚀"]}]}]`, suite.processor.Decode)
+	assert.JSONEq(suite.T(), `[{"t":"Plain","c":[{"t":"Str","c":":rocket:"},{"t":"Code","c":[["",[],[]],"🚀"]}]}]`, result)
+}
+
+// TestMetadata tests that document metadata such as the title is converted
+func (suite *FilterTestSuite) TestMetadata() {
+	input := `{"pandoc-api-version":[1,23,1],"meta":{"title":{"t":"MetaInlines","c":[{"t":"Str","c":":memo:"}]}},"blocks":[]}`
+
+	var output bytes.Buffer
+	require.NoError(suite.T(), Filter(strings.NewReader(input), &output, suite.processor.Process))
+	assert.JSONEq(suite.T(), `{"pandoc-api-version":[1,23,1],"meta":{"title":{"t":"MetaInlines","c":[{"t":"Str","c":"📝"}]}},"blocks":[]}`, output.String())
+}
+
+// TestPreservesDocument tests that numbers and HTML characters are written back unchanged
+func (suite *FilterTestSuite) TestPreservesDocument() {
+	input := `{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[{"t":"OrderedList","c":[[3,{"t":"Decimal"},{"t":"Period"}],[[{"t":"Plain","c":[{"t":"Str","c":"a<b&c"}]}]]]}]}`
+
+	var output bytes.Buffer
+	require.NoError(suite.T(), Filter(strings.NewReader(input), &output, suite.processor.Process))
+	assert.Contains(suite.T(), output.String(), `"a<b&c"`)
+	assert.JSONEq(suite.T(), input, output.String())
+}
+
+// TestInvalidInput tests that input other than a pandoc JSON document is rejected
+func (suite *FilterTestSuite) TestInvalidInput() {
+	for _, input := range []string{"", "# Markdown", `{"blocks":[]}`} {
+		var output bytes.Buffer
+		err := Filter(strings.NewReader(input), &output, suite.processor.Process)
+		assert.Error(suite.T(), err, "Input %q", input)
+	}
+}
+
+// TestPandocFilter runs all pandoc filter tests
+func TestPandocFilter(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: emojify/v1/emojify.proto
+
+package emojifyv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Direction int32
+
+const (
+	Direction_DIRECTION_UNSPECIFIED Direction = 0
+	Direction_DIRECTION_ENCODE      Direction = 1
+	Direction_DIRECTION_DECODE      Direction = 2
+)
+
+// Enum value maps for Direction.
+var (
+	Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "DIRECTION_ENCODE",
+		2: "DIRECTION_DECODE",
+	}
+	Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"DIRECTION_ENCODE":      1,
+		"DIRECTION_DECODE":      2,
+	}
+)
+
+func (x Direction) Enum() *Direction {
+	p := new(Direction)
+	*p = x
+	return p
+}
+
+func (x Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_emojify_v1_emojify_proto_enumTypes[0].Descriptor()
+}
+
+func (Direction) Type() protoreflect.EnumType {
+	return &file_emojify_v1_emojify_proto_enumTypes[0]
+}
+
+func (x Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Direction.Descriptor instead.
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{0}
+}
+
+type EncodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncodeRequest) Reset() {
+	*x = EncodeRequest{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeRequest) ProtoMessage() {}
+
+func (x *EncodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeRequest.ProtoReflect.Descriptor instead.
+func (*EncodeRequest) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EncodeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type EncodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncodeResponse) Reset() {
+	*x = EncodeResponse{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeResponse) ProtoMessage() {}
+
+func (x *EncodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeResponse.ProtoReflect.Descriptor instead.
+func (*EncodeResponse) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EncodeResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DecodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeRequest) Reset() {
+	*x = DecodeRequest{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeRequest) ProtoMessage() {}
+
+func (x *DecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeRequest.ProtoReflect.Descriptor instead.
+func (*DecodeRequest) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DecodeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DecodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeResponse) Reset() {
+	*x = DecodeResponse{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeResponse) ProtoMessage() {}
+
+func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeResponse.ProtoReflect.Descriptor instead.
+func (*DecodeResponse) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DecodeResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Emoji describes an emoji and every alias that produces it
+type Emoji struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Emoji string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// The alias the emoji decodes to, or the alias that matched a search
+	Alias          string   `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Aliases        []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Name           string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Group          string   `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	Subgroup       string   `protobuf:"bytes,6,opt,name=subgroup,proto3" json:"subgroup,omitempty"`
+	UnicodeVersion string   `protobuf:"bytes,7,opt,name=unicode_version,json=unicodeVersion,proto3" json:"unicode_version,omitempty"`
+	Codepoints     string   `protobuf:"bytes,8,opt,name=codepoints,proto3" json:"codepoints,omitempty"`
+	// The meaning of the emoji in the gitmoji commit convention, if it has one
+	Gitmoji       string `protobuf:"bytes,9,opt,name=gitmoji,proto3" json:"gitmoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Emoji) Reset() {
+	*x = Emoji{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Emoji) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emoji) ProtoMessage() {}
+
+func (x *Emoji) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emoji.ProtoReflect.Descriptor instead.
+func (*Emoji) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Emoji) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Emoji) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Emoji) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Emoji) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Emoji) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Emoji) GetSubgroup() string {
+	if x != nil {
+		return x.Subgroup
+	}
+	return ""
+}
+
+func (x *Emoji) GetUnicodeVersion() string {
+	if x != nil {
+		return x.UnicodeVersion
+	}
+	return ""
+}
+
+func (x *Emoji) GetCodepoints() string {
+	if x != nil {
+		return x.Codepoints
+	}
+	return ""
+}
+
+func (x *Emoji) GetGitmoji() string {
+	if x != nil {
+		return x.Gitmoji
+	}
+	return ""
+}
+
+type LookupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An alias, with or without colons, or an emoji
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupRequest) Reset() {
+	*x = LookupRequest{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRequest) ProtoMessage() {}
+
+func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LookupRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type LookupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         *Emoji                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupResponse) Reset() {
+	*x = LookupResponse{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResponse) ProtoMessage() {}
+
+func (x *LookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResponse.ProtoReflect.Descriptor instead.
+func (*LookupResponse) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LookupResponse) GetEmoji() *Emoji {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of results; 0 uses the server default
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Emoji               `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchResponse) GetResults() []*Emoji {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TransformRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required on the first message; later messages must leave it unset or repeat it
+	Direction     Direction `protobuf:"varint,1,opt,name=direction,proto3,enum=emojify.v1.Direction" json:"direction,omitempty"`
+	Text          string    `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformRequest) Reset() {
+	*x = TransformRequest{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformRequest) ProtoMessage() {}
+
+func (x *TransformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformRequest.ProtoReflect.Descriptor instead.
+func (*TransformRequest) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransformRequest) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_DIRECTION_UNSPECIFIED
+}
+
+func (x *TransformRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TransformResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformResponse) Reset() {
+	*x = TransformResponse{}
+	mi := &file_emojify_v1_emojify_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformResponse) ProtoMessage() {}
+
+func (x *TransformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emojify_v1_emojify_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformResponse.ProtoReflect.Descriptor instead.
+func (*TransformResponse) Descriptor() ([]byte, []int) {
+	return file_emojify_v1_emojify_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransformResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+var File_emojify_v1_emojify_proto protoreflect.FileDescriptor
+
+const file_emojify_v1_emojify_proto_rawDesc = "" +
+	"\n" +
+	"\x18emojify/v1/emojify.proto\x12\n" +
+	"emojify.v1\"#\n" +
+	"\rEncodeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"$\n" +
+	"\x0eEncodeResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"#\n" +
+	"\rDecodeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"$\n" +
+	"\x0eDecodeResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\xf6\x01\n" +
+	"\x05Emoji\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05group\x18\x05 \x01(\tR\x05group\x12\x1a\n" +
+	"\bsubgroup\x18\x06 \x01(\tR\bsubgroup\x12'\n" +
+	"\x0funicode_version\x18\a \x01(\tR\x0eunicodeVersion\x12\x1e\n" +
+	"\n" +
+	"codepoints\x18\b \x01(\tR\n" +
+	"codepoints\x12\x18\n" +
+	"\agitmoji\x18\t \x01(\tR\agitmoji\"%\n" +
+	"\rLookupRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"9\n" +
+	"\x0eLookupResponse\x12'\n" +
+	"\x05emoji\x18\x01 \x01(\v2\x11.emojify.v1.EmojiR\x05emoji\";\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"=\n" +
+	"\x0eSearchResponse\x12+\n" +
+	"\aresults\x18\x01 \x03(\v2\x11.emojify.v1.EmojiR\aresults\"[\n" +
+	"\x10TransformRequest\x123\n" +
+	"\tdirection\x18\x01 \x01(\x0e2\x15.emojify.v1.DirectionR\tdirection\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"'\n" +
+	"\x11TransformResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text*R\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10DIRECTION_ENCODE\x10\x01\x12\x14\n" +
+	"\x10DIRECTION_DECODE\x10\x022\xe2\x02\n" +
+	"\x0eEmojifyService\x12?\n" +
+	"\x06Encode\x12\x19.emojify.v1.EncodeRequest\x1a\x1a.emojify.v1.EncodeResponse\x12?\n" +
+	"\x06Decode\x12\x19.emojify.v1.DecodeRequest\x1a\x1a.emojify.v1.DecodeResponse\x12?\n" +
+	"\x06Lookup\x12\x19.emojify.v1.LookupRequest\x1a\x1a.emojify.v1.LookupResponse\x12?\n" +
+	"\x06Search\x12\x19.emojify.v1.SearchRequest\x1a\x1a.emojify.v1.SearchResponse\x12L\n" +
+	"\tTransform\x12\x1c.emojify.v1.TransformRequest\x1a\x1d.emojify.v1.TransformResponse(\x010\x01BCZAgithub.com/damienbutt/emojify-go/internal/rpc/emojifyv1;emojifyv1b\x06proto3"
+
+var (
+	file_emojify_v1_emojify_proto_rawDescOnce sync.Once
+	file_emojify_v1_emojify_proto_rawDescData []byte
+)
+
+func file_emojify_v1_emojify_proto_rawDescGZIP() []byte {
+	file_emojify_v1_emojify_proto_rawDescOnce.Do(func() {
+		file_emojify_v1_emojify_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_emojify_v1_emojify_proto_rawDesc), len(file_emojify_v1_emojify_proto_rawDesc)))
+	})
+	return file_emojify_v1_emojify_proto_rawDescData
+}
+
+var file_emojify_v1_emojify_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_emojify_v1_emojify_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_emojify_v1_emojify_proto_goTypes = []any{
+	(Direction)(0),            // 0: emojify.v1.Direction
+	(*EncodeRequest)(nil),     // 1: emojify.v1.EncodeRequest
+	(*EncodeResponse)(nil),    // 2: emojify.v1.EncodeResponse
+	(*DecodeRequest)(nil),     // 3: emojify.v1.DecodeRequest
+	(*DecodeResponse)(nil),    // 4: emojify.v1.DecodeResponse
+	(*Emoji)(nil),             // 5: emojify.v1.Emoji
+	(*LookupRequest)(nil),     // 6: emojify.v1.LookupRequest
+	(*LookupResponse)(nil),    // 7: emojify.v1.LookupResponse
+	(*SearchRequest)(nil),     // 8: emojify.v1.SearchRequest
+	(*SearchResponse)(nil),    // 9: emojify.v1.SearchResponse
+	(*TransformRequest)(nil),  // 10: emojify.v1.TransformRequest
+	(*TransformResponse)(nil), // 11: emojify.v1.TransformResponse
+}
+var file_emojify_v1_emojify_proto_depIdxs = []int32{
+	5,  // 0: emojify.v1.LookupResponse.emoji:type_name -> emojify.v1.Emoji
+	5,  // 1: emojify.v1.SearchResponse.results:type_name -> emojify.v1.Emoji
+	0,  // 2: emojify.v1.TransformRequest.direction:type_name -> emojify.v1.Direction
+	1,  // 3: emojify.v1.EmojifyService.Encode:input_type -> emojify.v1.EncodeRequest
+	3,  // 4: emojify.v1.EmojifyService.Decode:input_type -> emojify.v1.DecodeRequest
+	6,  // 5: emojify.v1.EmojifyService.Lookup:input_type -> emojify.v1.LookupRequest
+	8,  // 6: emojify.v1.EmojifyService.Search:input_type -> emojify.v1.SearchRequest
+	10, // 7: emojify.v1.EmojifyService.Transform:input_type -> emojify.v1.TransformRequest
+	2,  // 8: emojify.v1.EmojifyService.Encode:output_type -> emojify.v1.EncodeResponse
+	4,  // 9: emojify.v1.EmojifyService.Decode:output_type -> emojify.v1.DecodeResponse
+	7,  // 10: emojify.v1.EmojifyService.Lookup:output_type -> emojify.v1.LookupResponse
+	9,  // 11: emojify.v1.EmojifyService.Search:output_type -> emojify.v1.SearchResponse
+	11, // 12: emojify.v1.EmojifyService.Transform:output_type -> emojify.v1.TransformResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_emojify_v1_emojify_proto_init() }
+func file_emojify_v1_emojify_proto_init() {
+	if File_emojify_v1_emojify_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_emojify_v1_emojify_proto_rawDesc), len(file_emojify_v1_emojify_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_emojify_v1_emojify_proto_goTypes,
+		DependencyIndexes: file_emojify_v1_emojify_proto_depIdxs,
+		EnumInfos:         file_emojify_v1_emojify_proto_enumTypes,
+		MessageInfos:      file_emojify_v1_emojify_proto_msgTypes,
+	}.Build()
+	File_emojify_v1_emojify_proto = out.File
+	file_emojify_v1_emojify_proto_goTypes = nil
+	file_emojify_v1_emojify_proto_depIdxs = nil
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: emojify/v1/emojify.proto
+
+package emojifyv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmojifyService_Encode_FullMethodName    = "/emojify.v1.EmojifyService/Encode"
+	EmojifyService_Decode_FullMethodName    = "/emojify.v1.EmojifyService/Decode"
+	EmojifyService_Lookup_FullMethodName    = "/emojify.v1.EmojifyService/Lookup"
+	EmojifyService_Search_FullMethodName    = "/emojify.v1.EmojifyService/Search"
+	EmojifyService_Transform_FullMethodName = "/emojify.v1.EmojifyService/Transform"
+)
+
+// EmojifyServiceClient is the client API for EmojifyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EmojifyService converts between emoji aliases and emoji characters
+type EmojifyServiceClient interface {
+	// Encode replaces aliases such as :rocket: with emoji
+	Encode(ctx context.Context, in *EncodeRequest, opts ...grpc.CallOption) (*EncodeResponse, error)
+	// Decode replaces emoji with their aliases
+	Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error)
+	// Lookup describes a single alias or emoji
+	Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error)
+	// Search finds emoji by alias or name
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Transform converts a long text sent as a stream of chunks
+	//
+	// Output is streamed back as soon as it is complete. Text is buffered up
+	// to the last whitespace in each chunk, so aliases and emoji may be split
+	// across chunks freely.
+	Transform(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TransformRequest, TransformResponse], error)
+}
+
+type emojifyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmojifyServiceClient(cc grpc.ClientConnInterface) EmojifyServiceClient {
+	return &emojifyServiceClient{cc}
+}
+
+func (c *emojifyServiceClient) Encode(ctx context.Context, in *EncodeRequest, opts ...grpc.CallOption) (*EncodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncodeResponse)
+	err := c.cc.Invoke(ctx, EmojifyService_Encode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emojifyServiceClient) Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodeResponse)
+	err := c.cc.Invoke(ctx, EmojifyService_Decode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emojifyServiceClient) Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupResponse)
+	err := c.cc.Invoke(ctx, EmojifyService_Lookup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emojifyServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, EmojifyService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emojifyServiceClient) Transform(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TransformRequest, TransformResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmojifyService_ServiceDesc.Streams[0], EmojifyService_Transform_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TransformRequest, TransformResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmojifyService_TransformClient = grpc.BidiStreamingClient[TransformRequest, TransformResponse]
+
+// EmojifyServiceServer is the server API for EmojifyService service.
+// All implementations must embed UnimplementedEmojifyServiceServer
+// for forward compatibility.
+//
+// EmojifyService converts between emoji aliases and emoji characters
+type EmojifyServiceServer interface {
+	// Encode replaces aliases such as :rocket: with emoji
+	Encode(context.Context, *EncodeRequest) (*EncodeResponse, error)
+	// Decode replaces emoji with their aliases
+	Decode(context.Context, *DecodeRequest) (*DecodeResponse, error)
+	// Lookup describes a single alias or emoji
+	Lookup(context.Context, *LookupRequest) (*LookupResponse, error)
+	// Search finds emoji by alias or name
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Transform converts a long text sent as a stream of chunks
+	//
+	// Output is streamed back as soon as it is complete. Text is buffered up
+	// to the last whitespace in each chunk, so aliases and emoji may be split
+	// across chunks freely.
+	Transform(grpc.BidiStreamingServer[TransformRequest, TransformResponse]) error
+	mustEmbedUnimplementedEmojifyServiceServer()
+}
+
+// UnimplementedEmojifyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmojifyServiceServer struct{}
+
+func (UnimplementedEmojifyServiceServer) Encode(context.Context, *EncodeRequest) (*EncodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encode not implemented")
+}
+func (UnimplementedEmojifyServiceServer) Decode(context.Context, *DecodeRequest) (*DecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decode not implemented")
+}
+func (UnimplementedEmojifyServiceServer) Lookup(context.Context, *LookupRequest) (*LookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lookup not implemented")
+}
+func (UnimplementedEmojifyServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedEmojifyServiceServer) Transform(grpc.BidiStreamingServer[TransformRequest, TransformResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Transform not implemented")
+}
+func (UnimplementedEmojifyServiceServer) mustEmbedUnimplementedEmojifyServiceServer() {}
+func (UnimplementedEmojifyServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeEmojifyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmojifyServiceServer will
+// result in compilation errors.
+type UnsafeEmojifyServiceServer interface {
+	mustEmbedUnimplementedEmojifyServiceServer()
+}
+
+func RegisterEmojifyServiceServer(s grpc.ServiceRegistrar, srv EmojifyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmojifyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmojifyService_ServiceDesc, srv)
+}
+
+func _EmojifyService_Encode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmojifyServiceServer).Encode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmojifyService_Encode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmojifyServiceServer).Encode(ctx, req.(*EncodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmojifyService_Decode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmojifyServiceServer).Decode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmojifyService_Decode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmojifyServiceServer).Decode(ctx, req.(*DecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmojifyService_Lookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmojifyServiceServer).Lookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmojifyService_Lookup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmojifyServiceServer).Lookup(ctx, req.(*LookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmojifyService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmojifyServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmojifyService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmojifyServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmojifyService_Transform_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EmojifyServiceServer).Transform(&grpc.GenericServerStream[TransformRequest, TransformResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmojifyService_TransformServer = grpc.BidiStreamingServer[TransformRequest, TransformResponse]
+
+// EmojifyService_ServiceDesc is the grpc.ServiceDesc for EmojifyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmojifyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emojify.v1.EmojifyService",
+	HandlerType: (*EmojifyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Encode",
+			Handler:    _EmojifyService_Encode_Handler,
+		},
+		{
+			MethodName: "Decode",
+			Handler:    _EmojifyService_Decode_Handler,
+		},
+		{
+			MethodName: "Lookup",
+			Handler:    _EmojifyService_Lookup_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _EmojifyService_Search_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Transform",
+			Handler:       _EmojifyService_Transform_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "emojify/v1/emojify.proto",
+}
@@ -0,0 +1,148 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+	"github.com/damienbutt/emojify-go/internal/rpc/emojifyv1"
+)
+
+// Server implements the EmojifyService gRPC service with a Processor
+type Server struct {
+	emojifyv1.UnimplementedEmojifyServiceServer
+	processor *emojify.Processor
+}
+
+// NewServer creates a service that converts text with the given processor
+func NewServer(processor *emojify.Processor) *Server {
+	return &Server{processor: processor}
+}
+
+// Register adds the service to a gRPC server
+func Register(registrar grpc.ServiceRegistrar, processor *emojify.Processor) {
+	emojifyv1.RegisterEmojifyServiceServer(registrar, NewServer(processor))
+}
+
+// Encode replaces aliases with emoji
+func (s *Server) Encode(_ context.Context, request *emojifyv1.EncodeRequest) (*emojifyv1.EncodeResponse, error) {
+	return &emojifyv1.EncodeResponse{Text: s.processor.Process(request.GetText())}, nil
+}
+
+// Decode replaces emoji with aliases
+func (s *Server) Decode(_ context.Context, request *emojifyv1.DecodeRequest) (*emojifyv1.DecodeResponse, error) {
+	return &emojifyv1.DecodeResponse{Text: s.processor.Decode(request.GetText())}, nil
+}
+
+// Lookup describes a single alias or emoji
+func (s *Server) Lookup(_ context.Context, request *emojifyv1.LookupRequest) (*emojifyv1.LookupResponse, error) {
+	info, ok := emoji.Lookup(request.GetQuery())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown emoji %q", request.GetQuery())
+	}
+
+	return &emojifyv1.LookupResponse{Emoji: toProto(info)}, nil
+}
+
+// Search finds emoji by alias or name
+func (s *Server) Search(_ context.Context, request *emojifyv1.SearchRequest) (*emojifyv1.SearchResponse, error) {
+	if request.GetQuery() == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	limit := int(request.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit %d", limit)
+	case limit == 0:
+		limit = emoji.DefaultSearchLimit
+	}
+
+	results := emoji.Search(request.GetQuery(), min(limit, emoji.MaxSearchLimit))
+
+	response := &emojifyv1.SearchResponse{Results: make([]*emojifyv1.Emoji, len(results))}
+	for i, info := range results {
+		response.Results[i] = toProto(info)
+	}
+
+	return response, nil
+}
+
+// Transform converts a stream of text chunks, replying as output becomes complete
+func (s *Server) Transform(stream grpc.BidiStreamingServer[emojifyv1.TransformRequest, emojifyv1.TransformResponse]) error {
+	var (
+		direction emojifyv1.Direction
+		converter *emojify.StreamConverter
+	)
+
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if converter == nil {
+			direction = request.GetDirection()
+			if direction != emojifyv1.Direction_DIRECTION_ENCODE && direction != emojifyv1.Direction_DIRECTION_DECODE {
+				return status.Error(codes.InvalidArgument, "the first message must set direction to ENCODE or DECODE")
+			}
+
+			converter = s.converterFor(direction)
+		} else if request.GetDirection() != emojifyv1.Direction_DIRECTION_UNSPECIFIED && request.GetDirection() != direction {
+			return status.Error(codes.InvalidArgument, "direction cannot change during a stream")
+		}
+
+		if err := send(stream, converter.Write(request.GetText())); err != nil {
+			return err
+		}
+	}
+
+	if converter == nil {
+		return nil
+	}
+
+	return send(stream, converter.Flush())
+}
+
+// converterFor returns a stream converter for the given direction
+func (s *Server) converterFor(direction emojifyv1.Direction) *emojify.StreamConverter {
+	if direction == emojifyv1.Direction_DIRECTION_DECODE {
+		return s.processor.DecodeStream()
+	}
+
+	return s.processor.ProcessStream()
+}
+
+// send writes converted text to the stream, skipping empty chunks
+func send(stream grpc.BidiStreamingServer[emojifyv1.TransformRequest, emojifyv1.TransformResponse], text string) error {
+	if text == "" {
+		return nil
+	}
+
+	return stream.Send(&emojifyv1.TransformResponse{Text: text})
+}
+
+// toProto converts emoji information to its protobuf message
+func toProto(info emoji.Info) *emojifyv1.Emoji {
+	return &emojifyv1.Emoji{
+		Emoji:          info.Emoji,
+		Alias:          info.Alias,
+		Aliases:        info.Aliases,
+		Name:           info.Name,
+		Group:          info.Group,
+		Subgroup:       info.Subgroup,
+		UnicodeVersion: info.UnicodeVersion,
+		Codepoints:     info.Codepoints,
+		Gitmoji:        info.Gitmoji,
+	}
+}
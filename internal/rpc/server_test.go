@@ -0,0 +1,204 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+	"github.com/damienbutt/emojify-go/internal/rpc/emojifyv1"
+)
+
+// ServerTestSuite defines the test suite for the gRPC service, served over bufconn
+type ServerTestSuite struct {
+	suite.Suite
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	client emojifyv1.EmojifyServiceClient
+}
+
+// SetupTest starts an in-memory server and connects a client to it
+func (suite *ServerTestSuite) SetupTest() {
+	listener := bufconn.Listen(1 << 20)
+
+	suite.server = grpc.NewServer()
+	Register(suite.server, emojify.NewProcessor(emojify.WithSkinTone(emoji.SkinToneMedium)))
+
+	go func() {
+		_ = suite.server.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(suite.T(), err)
+
+	suite.conn = conn
+	suite.client = emojifyv1.NewEmojifyServiceClient(conn)
+}
+
+// TearDownTest stops the server and closes the client
+func (suite *ServerTestSuite) TearDownTest() {
+	suite.conn.Close()
+	suite.server.Stop()
+}
+
+// TestEncodeDecode tests the unary conversion methods
+func (suite *ServerTestSuite) TestEncodeDecode() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	ctx := context.Background()
+
+	encoded, err := suite.client.Encode(ctx, &emojifyv1.EncodeRequest{Text: "Deploy :rocket: :wave:"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Deploy 🚀 👋🏽", encoded.GetText(), "Processor options should apply")
+
+	decoded, err := suite.client.Decode(ctx, &emojifyv1.DecodeRequest{Text: "Deploy 🚀"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Deploy :rocket:", decoded.GetText())
+}
+
+// TestLookup tests looking up aliases and emoji
+func (suite *ServerTestSuite) TestLookup() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	ctx := context.Background()
+
+	response, err := suite.client.Lookup(ctx, &emojifyv1.LookupRequest{Query: "thumbsup"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "👍", response.GetEmoji().GetEmoji())
+	assert.Equal(suite.T(), []string{":+1:", ":thumbs_up:", ":thumbsup:"}, response.GetEmoji().GetAliases())
+	assert.Equal(suite.T(), "thumbs up", response.GetEmoji().GetName())
+
+	_, err = suite.client.Lookup(ctx, &emojifyv1.LookupRequest{Query: "not_an_emoji"})
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
+
+// TestSearch tests searching and its argument validation
+func (suite *ServerTestSuite) TestSearch() {
+	if !emoji.HasUnicodeData() {
+		suite.T().Skip("lite builds leave out the Unicode data")
+	}
+
+	ctx := context.Background()
+
+	response, err := suite.client.Search(ctx, &emojifyv1.SearchRequest{Query: "thumbs", Limit: 2})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), response.GetResults(), 2)
+	assert.Equal(suite.T(), ":thumbs_up:", response.GetResults()[0].GetAlias())
+
+	response, err = suite.client.Search(ctx, &emojifyv1.SearchRequest{Query: "heart"})
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), response.GetResults(), emoji.DefaultSearchLimit)
+
+	_, err = suite.client.Search(ctx, &emojifyv1.SearchRequest{})
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.client.Search(ctx, &emojifyv1.SearchRequest{Query: "cat", Limit: -1})
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
+
+// transform sends chunks over a Transform stream and returns the joined output
+func (suite *ServerTestSuite) transform(direction emojifyv1.Direction, chunks []string) (string, error) {
+	stream, err := suite.client.Transform(context.Background())
+	require.NoError(suite.T(), err)
+
+	for i, chunk := range chunks {
+		request := &emojifyv1.TransformRequest{Text: chunk}
+		if i == 0 {
+			request.Direction = direction
+		}
+
+		require.NoError(suite.T(), stream.Send(request))
+	}
+
+	require.NoError(suite.T(), stream.CloseSend())
+
+	var result strings.Builder
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return result.String(), nil
+		}
+
+		if err != nil {
+			return result.String(), err
+		}
+
+		result.WriteString(response.GetText())
+	}
+}
+
+// TestTransform tests the bidirectional streaming conversion
+func (suite *ServerTestSuite) TestTransform() {
+	output, err := suite.transform(emojifyv1.Direction_DIRECTION_ENCODE, []string{"Deploy :roc", "ket: done :ta", "da:"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Deploy 🚀 done 🎉", output)
+
+	output, err = suite.transform(emojifyv1.Direction_DIRECTION_DECODE, []string{"Ship 🚢", " and 🎉\n"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Ship :ship: and :tada:\n", output)
+
+	output, err = suite.transform(emojifyv1.Direction_DIRECTION_ENCODE, nil)
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), output)
+}
+
+// TestTransformStreamsOutput tests that completed text is sent before the stream ends
+func (suite *ServerTestSuite) TestTransformStreamsOutput() {
+	stream, err := suite.client.Transform(context.Background())
+	require.NoError(suite.T(), err)
+
+	require.NoError(suite.T(), stream.Send(&emojifyv1.TransformRequest{
+		Direction: emojifyv1.Direction_DIRECTION_ENCODE,
+		Text:      ":rocket: :ta",
+	}))
+
+	response, err := stream.Recv()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "🚀 ", response.GetText())
+
+	require.NoError(suite.T(), stream.CloseSend())
+	response, err = stream.Recv()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ":ta", response.GetText())
+}
+
+// TestTransformRequiresDirection tests direction validation
+func (suite *ServerTestSuite) TestTransformRequiresDirection() {
+	_, err := suite.transform(emojifyv1.Direction_DIRECTION_UNSPECIFIED, []string{":rocket:"})
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	stream, err := suite.client.Transform(context.Background())
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), stream.Send(&emojifyv1.TransformRequest{Direction: emojifyv1.Direction_DIRECTION_ENCODE, Text: "a"}))
+	require.NoError(suite.T(), stream.Send(&emojifyv1.TransformRequest{Direction: emojifyv1.Direction_DIRECTION_DECODE, Text: "b"}))
+
+	_, err = stream.Recv()
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
+
+// TestServer runs all gRPC server tests
+func TestServer(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+const (
+	// DefaultMaxBodySize is the largest request body accepted by default (1 MiB)
+	DefaultMaxBodySize = 1 << 20
+
+	// shutdownTimeout is how long in-flight requests get to finish during shutdown
+	shutdownTimeout = 10 * time.Second
+)
+
+// Server exposes a Processor and the emoji lookups over HTTP
+type Server struct {
+	processor   *emojify.Processor
+	maxBodySize int64
+	logger      *log.Logger
+	mux         *http.ServeMux
+}
+
+// Option configures optional Server behaviour
+type Option func(*Server)
+
+// WithMaxBodySize sets the largest request body accepted, in bytes
+func WithMaxBodySize(size int64) Option {
+	return func(s *Server) {
+		s.maxBodySize = size
+	}
+}
+
+// WithLogger sets the logger used for startup and shutdown messages
+func WithLogger(logger *log.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// TextRequest is the JSON body accepted by /encode and /decode
+type TextRequest struct {
+	Text string `json:"text"`
+}
+
+// TextResponse is the JSON body returned by /encode and /decode
+type TextResponse struct {
+	Text string `json:"text"`
+}
+
+// SearchResponse is the JSON body returned by /search
+type SearchResponse struct {
+	Query   string       `json:"query"`
+	Results []emoji.Info `json:"results"`
+}
+
+// ErrorResponse is the JSON body returned for failed requests
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// New creates a server that converts text with the given processor
+func New(processor *emojify.Processor, opts ...Option) *Server {
+	s := &Server{
+		processor:   processor,
+		maxBodySize: DefaultMaxBodySize,
+		logger:      log.New(io.Discard, "", 0),
+		mux:         http.NewServeMux(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.mux.HandleFunc("POST /encode", s.handleText(s.processor.Process))
+	s.mux.HandleFunc("POST /decode", s.handleText(s.processor.Decode))
+	s.mux.HandleFunc("GET /emoji/{alias}", s.handleEmoji)
+	s.mux.HandleFunc("GET /search", s.handleSearch)
+	s.mux.HandleFunc("GET /healthz", s.handleHealth)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// ListenAndServe listens on addr and serves requests until ctx is cancelled
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+// Serve serves requests on listener until ctx is cancelled, then shuts down gracefully
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	httpServer := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- httpServer.Serve(listener)
+	}()
+
+	s.logger.Printf("listening on http://%s", listener.Addr())
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.logger.Printf("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// handleText converts a text/plain or JSON request body with the given function
+func (s *Server) handleText(convert func(string) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				s.writeError(w, r, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", s.maxBodySize))
+				return
+			}
+
+			s.writeError(w, r, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+
+		if !isJSON(r.Header.Get("Content-Type")) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			_, _ = io.WriteString(w, convert(string(body)))
+			return
+		}
+
+		var request TextRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			s.writeError(w, r, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusOK, TextResponse{Text: convert(request.Text)})
+	}
+}
+
+// handleEmoji returns information about a single alias or emoji
+func (s *Server) handleEmoji(w http.ResponseWriter, r *http.Request) {
+	alias := r.PathValue("alias")
+
+	info, ok := emoji.Lookup(alias)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, ErrorResponse{Error: fmt.Sprintf("unknown emoji %q", alias)})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+// handleSearch finds emoji by alias or name
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "missing q parameter"})
+		return
+	}
+
+	limit := emoji.DefaultSearchLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("invalid limit %q", value)})
+			return
+		}
+
+		limit = min(parsed, emoji.MaxSearchLimit)
+	}
+
+	results := emoji.Search(query, limit)
+	if results == nil {
+		results = []emoji.Info{}
+	}
+
+	writeJSON(w, http.StatusOK, SearchResponse{Query: query, Results: results})
+}
+
+// handleHealth reports that the server is running
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = io.WriteString(w, "ok\n")
+}
+
+// writeError reports an error as JSON or plain text, matching the request body
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	if isJSON(r.Header.Get("Content-Type")) {
+		writeJSON(w, status, ErrorResponse{Error: message})
+		return
+	}
+
+	http.Error(w, message, status)
+}
+
+// writeJSON writes value as a JSON response
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+}
+
+// isJSON reports whether a Content-Type header names a JSON media type
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
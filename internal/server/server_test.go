@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emoji"
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// ServerTestSuite defines the test suite for the HTTP server
+type ServerTestSuite struct {
+	suite.Suite
+	server *Server
+}
+
+// SetupTest creates a fresh server for each test
+func (suite *ServerTestSuite) SetupTest() {
+	suite.server = New(emojify.NewProcessor(), WithMaxBodySize(64))
+}
+
+// do sends a request to the server and returns the response
+func (suite *ServerTestSuite) do(method, target, contentType, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	recorder := httptest.NewRecorder()
+	suite.server.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+// TestTextBodies tests /encode and /decode with text/plain bodies
+func (suite *ServerTestSuite) TestTextBodies() {
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		expected string
+	}{
+		{name: "encode", target: "/encode", body: "Deploy :rocket:", expected: "Deploy 🚀"},
+		{name: "decode", target: "/decode", body: "Deploy 🚀", expected: "Deploy :rocket:"},
+		{name: "empty body", target: "/encode", body: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			response := suite.do(http.MethodPost, tt.target, "text/plain", tt.body)
+			assert.Equal(suite.T(), http.StatusOK, response.Code)
+			assert.Equal(suite.T(), tt.expected, response.Body.String())
+			assert.Contains(suite.T(), response.Header().Get("Content-Type"), "text/plain")
+		})
+	}
+}
+
+// TestJSONBodies tests /encode and /decode with JSON bodies
+func (suite *ServerTestSuite) TestJSONBodies() {
+	response := suite.do(http.MethodPost, "/encode", "application/json; charset=utf-8", `{"text": "Ship it :ship:"}`)
+	require.Equal(suite.T(), http.StatusOK, response.Code)
+
+	var result TextResponse
+	require.NoError(suite.T(), json.Unmarshal(response.Body.Bytes(), &result))
+	assert.Equal(suite.T(), "Ship it 🚢", result.Text)
+
+	response = suite.do(http.MethodPost, "/decode", "application/json", `{"text": "🚢 <b>"}`)
+	require.Equal(suite.T(), http.StatusOK, response.Code)
+	assert.Equal(suite.T(), "{\"text\":\":ship: <b>\"}\n", response.Body.String(), "HTML should not be escaped")
+
+	response = suite.do(http.MethodPost, "/encode", "application/json", `{"text":`)
+	assert.Equal(suite.T(), http.StatusBadRequest, response.Code)
+	assert.Contains(suite.T(), response.Body.String(), `"error"`)
+}
+
+// TestBodySizeLimit tests that oversized bodies are rejected
+func (suite *ServerTestSuite) TestBodySizeLimit() {
+	response := suite.do(http.MethodPost, "/encode", "text/plain", strings.Repeat("a", 65))
+	assert.Equal(suite.T(), http.StatusRequestEntityTooLarge, response.Code)
+
+	response = suite.do(http.MethodPost, "/encode", "application/json", `{"text": "`+strings.Repeat("a", 64)+`"}`)
+	assert.Equal(suite.T(), http.StatusRequestEntityTooLarge, response.Code)
+	assert.Contains(suite.T(), response.Body.String(), "exceeds 64 bytes")
+
+	response = suite.do(http.MethodPost, "/encode", "text/plain", strings.Repeat("a", 64))
+	assert.Equal(suite.T(), http.StatusOK, response.Code)
+}
+
+// TestEmojiLookup tests GET /emoji/{alias}
+func (suite *ServerTestSuite) TestEmojiLookup() {
+	for _, alias := range []string{"rocket", ":rocket:", "%F0%9F%9A%80"} {
+		response := suite.do(http.MethodGet, "/emoji/"+alias, "", "")
+		require.Equal(suite.T(), http.StatusOK, response.Code, "Alias %s", alias)
+
+		var info emoji.Info
+		require.NoError(suite.T(), json.Unmarshal(response.Body.Bytes(), &info))
+		assert.Equal(suite.T(), "🚀", info.Emoji)
+		assert.Equal(suite.T(), ":rocket:", info.Alias)
+	}
+
+	response := suite.do(http.MethodGet, "/emoji/not_an_emoji", "", "")
+	assert.Equal(suite.T(), http.StatusNotFound, response.Code)
+}
+
+// TestSearch tests GET /search
+func (suite *ServerTestSuite) TestSearch() {
+	response := suite.do(http.MethodGet, "/search?q=thumbs&limit=1", "", "")
+	require.Equal(suite.T(), http.StatusOK, response.Code)
+
+	var result SearchResponse
+	require.NoError(suite.T(), json.Unmarshal(response.Body.Bytes(), &result))
+	assert.Equal(suite.T(), "thumbs", result.Query)
+	require.Len(suite.T(), result.Results, 1)
+	assert.Equal(suite.T(), "👍", result.Results[0].Emoji)
+
+	response = suite.do(http.MethodGet, "/search?q=zzzzqqq", "", "")
+	require.Equal(suite.T(), http.StatusOK, response.Code)
+	assert.Contains(suite.T(), response.Body.String(), `"results":[]`)
+
+	for _, target := range []string{"/search", "/search?q=cat&limit=0", "/search?q=cat&limit=x"} {
+		response = suite.do(http.MethodGet, target, "", "")
+		assert.Equal(suite.T(), http.StatusBadRequest, response.Code, "Target %s", target)
+	}
+}
+
+// TestMethodNotAllowed tests that endpoints only accept their method
+func (suite *ServerTestSuite) TestMethodNotAllowed() {
+	response := suite.do(http.MethodGet, "/encode", "", "")
+	assert.Equal(suite.T(), http.StatusMethodNotAllowed, response.Code)
+
+	response = suite.do(http.MethodGet, "/healthz", "", "")
+	assert.Equal(suite.T(), http.StatusOK, response.Code)
+}
+
+// TestGracefulShutdown tests that Serve finishes in-flight requests when cancelled
+func (suite *ServerTestSuite) TestGracefulShutdown() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(suite.T(), err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- suite.server.Serve(ctx, listener)
+	}()
+
+	response, err := http.Post("http://"+listener.Addr().String()+"/encode", "text/plain", strings.NewReader(":tada:"))
+	require.NoError(suite.T(), err)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(suite.T(), err)
+	response.Body.Close()
+	assert.Equal(suite.T(), "🎉", string(body))
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(suite.T(), err)
+	case <-time.After(5 * time.Second):
+		suite.T().Fatal("Server did not shut down")
+	}
+}
+
+// TestServer runs all server tests
+func TestServer(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
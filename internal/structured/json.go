@@ -0,0 +1,119 @@
+package structured
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// jsonFrame is an object or array the JSON scanner is inside
+type jsonFrame struct {
+	object    bool
+	key       string // current key, for objects
+	index     int    // current index, for arrays
+	expectKey bool   // the next string in an object is a key
+}
+
+// jsonEdits returns the edits converting the selected string values of a JSON document
+func jsonEdits(document string, selector Selector, convert func(string) string) ([]edit, error) {
+	if err := validateJSON(document); err != nil {
+		return nil, err
+	}
+
+	var edits []edit
+	var stack []*jsonFrame
+
+	// The document is valid, so a flat scan only has to track structure and strings
+	for i := 0; i < len(document); {
+		var top *jsonFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		switch document[i] {
+		case '{':
+			stack = append(stack, &jsonFrame{object: true, expectKey: true})
+			i++
+		case '[':
+			stack = append(stack, &jsonFrame{})
+			i++
+		case '}', ']':
+			stack = stack[:len(stack)-1]
+			i++
+		case ',':
+			if top.object {
+				top.expectKey = true
+			} else {
+				top.index++
+			}
+			i++
+		case '"':
+			end := endOfJSONString(document, i)
+			raw := document[i:end]
+
+			var value string
+			if err := json.Unmarshal([]byte(raw), &value); err != nil {
+				return nil, err
+			}
+
+			if top != nil && top.object && top.expectKey {
+				top.key, top.expectKey = value, false
+			} else if selector.Match(jsonPath(stack)) {
+				if converted := convert(value); converted != value {
+					edits = append(edits, edit{start: i, end: end, text: quoteJSON(converted)})
+				}
+			}
+
+			i = end
+		default:
+			i++
+		}
+	}
+
+	return edits, nil
+}
+
+// endOfJSONString returns the offset just past the string literal starting at start
+func endOfJSONString(document string, start int) int {
+	for i := start + 1; i < len(document); i++ {
+		switch document[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+
+	return len(document)
+}
+
+// jsonPath returns the path of the value at the current position of the scanner
+func jsonPath(stack []*jsonFrame) []any {
+	path := make([]any, 0, len(stack))
+	for _, frame := range stack {
+		if frame.object {
+			path = append(path, frame.key)
+		} else {
+			path = append(path, frame.index)
+		}
+	}
+
+	return path
+}
+
+// validateJSON checks that document is a single JSON value
+func validateJSON(document string) error {
+	var value any
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line := strings.Count(document[:syntaxErr.Offset], "\n") + 1
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
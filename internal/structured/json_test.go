@@ -0,0 +1,98 @@
+package structured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// JSONTestSuite defines the test suite for JSON documents
+type JSONTestSuite struct {
+	suite.Suite
+	processor *emojify.Processor
+}
+
+// SetupTest creates a processor for each test
+func (suite *JSONTestSuite) SetupTest() {
+	suite.processor = emojify.NewProcessor()
+}
+
+// TestTransform tests that only string values are converted
+func (suite *JSONTestSuite) TestTransform() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "values but not keys",
+			input:    `{":bug:": ":bug:", "n": 1}`,
+			expected: `{":bug:": "🐛", "n": 1}`,
+		},
+		{
+			name:     "formatting and key order",
+			input:    "{\n  \"z\":   \":rocket:\",\n\n  \"a\" : [ \":tada:\" ,true ]\n}\n",
+			expected: "{\n  \"z\":   \"🚀\",\n\n  \"a\" : [ \"🎉\" ,true ]\n}\n",
+		},
+		{
+			name:     "top-level string",
+			input:    `":fire:"`,
+			expected: `"🔥"`,
+		},
+		{
+			name:     "escaped strings",
+			input:    `{"a": "say \"hi\" :wave:\n", "b": "A :bug:"}`,
+			expected: `{"a": "say \"hi\" 👋\n", "b": "A 🐛"}`,
+		},
+		{
+			name:     "nested objects",
+			input:    `[{"a": {"b": [":bug:"]}}, ":x:"]`,
+			expected: `[{"a": {"b": ["🐛"]}}, "❌"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := Transform(tt.input, FormatJSON, Selector{}, suite.processor.Process)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result)
+		})
+	}
+}
+
+// TestSelector tests that only selected values are converted
+func (suite *JSONTestSuite) TestSelector() {
+	input := `{"title": ":bug:", "id": ":bug:", "items": [{"title": ":fire:"}, {"title": ":tada:"}]}`
+
+	selector, err := ParseSelector("$.items[1].title", "$.title")
+	require.NoError(suite.T(), err)
+
+	result, err := Transform(input, FormatJSON, selector, suite.processor.Process)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `{"title": "🐛", "id": ":bug:", "items": [{"title": ":fire:"}, {"title": "🎉"}]}`, result)
+}
+
+// TestRequoting tests that converted values needing escapes stay valid JSON
+func (suite *JSONTestSuite) TestRequoting() {
+	quote := func(text string) string { return text + ` "<b>" \ ` }
+
+	result, err := Transform(`{"a": "x"}`, FormatJSON, Selector{}, quote)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `{"a": "x \"<b>\" \\ "}`, result)
+}
+
+// TestDecode tests converting emoji back to aliases
+func (suite *JSONTestSuite) TestDecode() {
+	result, err := Transform(`{"🚀": "🚀"}`, FormatJSON, Selector{}, suite.processor.Decode)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `{"🚀": ":rocket:"}`, result)
+}
+
+// TestJSON runs all JSON tests
+func TestJSON(t *testing.T) {
+	suite.Run(t, new(JSONTestSuite))
+}
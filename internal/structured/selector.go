@@ -0,0 +1,192 @@
+package structured
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Selector restricts conversion to the values at matching paths
+//
+// Expressions are a subset of JSONPath: "$.releases[*].notes" selects the
+// notes of every release, "$..title" every title key, and "$.bot['motd']"
+// a key that needs quoting. An expression without a leading "$" matches
+// anywhere in the document, so "notes" is short for "$..notes". Selecting an
+// object or array selects every string inside it. The zero Selector matches
+// every value.
+type Selector struct {
+	expressions [][]step
+}
+
+// step is one component of a selector expression
+type step struct {
+	key       string
+	index     int
+	isIndex   bool
+	wildcard  bool
+	recursive bool // matches at any depth below the previous step
+}
+
+// ParseSelector parses selector expressions, any of which may match
+func ParseSelector(expressions ...string) (Selector, error) {
+	var selector Selector
+
+	for _, expression := range expressions {
+		steps, err := parseExpression(expression)
+		if err != nil {
+			return Selector{}, fmt.Errorf("invalid selector %q: %w", expression, err)
+		}
+
+		selector.expressions = append(selector.expressions, steps)
+	}
+
+	return selector, nil
+}
+
+// parseExpression parses a single selector expression into steps
+func parseExpression(expression string) ([]step, error) {
+	rest := strings.TrimSpace(expression)
+	if rest == "" {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	if after, ok := strings.CutPrefix(rest, "$"); ok {
+		rest = after
+	} else if strings.HasPrefix(rest, "[") {
+		rest = ".." + rest
+	} else {
+		rest = ".." + strings.TrimPrefix(rest, ".")
+	}
+
+	var steps []step
+	for rest != "" {
+		recursive := false
+		if after, ok := strings.CutPrefix(rest, ".."); ok {
+			recursive, rest = true, after
+		} else if after, ok := strings.CutPrefix(rest, "."); ok {
+			rest = after
+		} else if !strings.HasPrefix(rest, "[") {
+			return nil, fmt.Errorf("expected '.' or '[' before %q", rest)
+		}
+
+		var s step
+		var err error
+		if strings.HasPrefix(rest, "[") {
+			s, rest, err = parseBracket(rest)
+		} else {
+			s, rest, err = parseName(rest)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		s.recursive = recursive
+		steps = append(steps, s)
+	}
+
+	return steps, nil
+}
+
+// parseName parses a dotted key or "*" at the start of rest
+func parseName(rest string) (step, string, error) {
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+
+	name := rest[:end]
+	if name == "" {
+		return step{}, "", fmt.Errorf("expected a key before %q", rest)
+	}
+
+	if name == "*" {
+		return step{wildcard: true}, rest[end:], nil
+	}
+
+	return step{key: name}, rest[end:], nil
+}
+
+// parseBracket parses [index], [*] or a quoted ['key'] at the start of rest
+func parseBracket(rest string) (step, string, error) {
+	inner := rest[1:]
+
+	if quote := inner[:min(1, len(inner))]; quote == "'" || quote == `"` {
+		end := strings.Index(inner[1:], quote+"]")
+		if end < 0 {
+			return step{}, "", fmt.Errorf("unterminated quoted key in %q", rest)
+		}
+
+		return step{key: inner[1 : end+1]}, inner[end+3:], nil
+	}
+
+	end := strings.Index(inner, "]")
+	if end < 0 {
+		return step{}, "", fmt.Errorf("missing ']' in %q", rest)
+	}
+
+	content := strings.TrimSpace(inner[:end])
+	if content == "*" {
+		return step{wildcard: true}, inner[end+1:], nil
+	}
+
+	index, err := strconv.Atoi(content)
+	if err != nil || index < 0 {
+		return step{}, "", fmt.Errorf("invalid index %q", content)
+	}
+
+	return step{index: index, isIndex: true}, inner[end+1:], nil
+}
+
+// Match reports whether the value at path is selected
+//
+// Path elements are object keys (string) and array indices (int).
+func (s Selector) Match(path []any) bool {
+	if len(s.expressions) == 0 {
+		return true
+	}
+
+	for _, steps := range s.expressions {
+		if matchSteps(steps, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchSteps reports whether steps match path or one of its ancestors
+func matchSteps(steps []step, path []any) bool {
+	if len(steps) == 0 {
+		return true
+	}
+
+	first := steps[0]
+	if !first.recursive {
+		return len(path) > 0 && first.matches(path[0]) && matchSteps(steps[1:], path[1:])
+	}
+
+	for i := range path {
+		if first.matches(path[i]) && matchSteps(steps[1:], path[i+1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches reports whether a single path element satisfies the step
+func (s step) matches(element any) bool {
+	if s.wildcard {
+		return true
+	}
+
+	switch value := element.(type) {
+	case int:
+		return s.isIndex && s.index == value
+	case string:
+		return !s.isIndex && s.key == value
+	default:
+		return false
+	}
+}
@@ -0,0 +1,122 @@
+package structured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// SelectorTestSuite defines the test suite for selectors
+type SelectorTestSuite struct {
+	suite.Suite
+}
+
+// TestMatch tests which paths selector expressions match
+func (suite *SelectorTestSuite) TestMatch() {
+	tests := []struct {
+		name       string
+		expression string
+		matches    [][]any
+		misses     [][]any
+	}{
+		{
+			name:       "root",
+			expression: "$",
+			matches:    [][]any{{}, {"a"}, {"a", 0, "b"}},
+		},
+		{
+			name:       "child key",
+			expression: "$.title",
+			matches:    [][]any{{"title"}},
+			misses:     [][]any{{}, {"name"}, {"post", "title"}},
+		},
+		{
+			name:       "descendants of a selected value",
+			expression: "$.release",
+			matches:    [][]any{{"release", "notes"}, {"release", 2}},
+			misses:     [][]any{{"releases", "notes"}},
+		},
+		{
+			name:       "index and wildcard",
+			expression: "$.releases[*].notes[1]",
+			matches:    [][]any{{"releases", 0, "notes", 1}, {"releases", 7, "notes", 1}},
+			misses:     [][]any{{"releases", 0, "notes", 0}, {"releases", "x", "notes"}},
+		},
+		{
+			name:       "dot wildcard",
+			expression: "$.*.title",
+			matches:    [][]any{{"post", "title"}, {3, "title"}},
+			misses:     [][]any{{"title"}, {"a", "b", "title"}},
+		},
+		{
+			name:       "recursive descent",
+			expression: "$..title",
+			matches:    [][]any{{"title"}, {"a", 0, "b", "title"}},
+			misses:     [][]any{{"subtitle"}},
+		},
+		{
+			name:       "bare key searches everywhere",
+			expression: "message.text",
+			matches:    [][]any{{"message", "text"}, {"log", 3, "message", "text"}},
+			misses:     [][]any{{"message", "id"}, {"text"}},
+		},
+		{
+			name:       "quoted keys",
+			expression: `$['a.b']["c d"]`,
+			matches:    [][]any{{"a.b", "c d"}},
+			misses:     [][]any{{"a", "b", "c d"}},
+		},
+		{
+			name:       "bare bracket",
+			expression: "[0]",
+			matches:    [][]any{{0}, {"list", 0}},
+			misses:     [][]any{{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			selector, err := ParseSelector(tt.expression)
+			require.NoError(suite.T(), err)
+
+			for _, path := range tt.matches {
+				assert.True(suite.T(), selector.Match(path), "Path %v", path)
+			}
+
+			for _, path := range tt.misses {
+				assert.False(suite.T(), selector.Match(path), "Path %v", path)
+			}
+		})
+	}
+}
+
+// TestMatchAny tests that a value is selected when any expression matches
+func (suite *SelectorTestSuite) TestMatchAny() {
+	selector, err := ParseSelector("$.title", "$.body")
+	require.NoError(suite.T(), err)
+
+	assert.True(suite.T(), selector.Match([]any{"title"}))
+	assert.True(suite.T(), selector.Match([]any{"body"}))
+	assert.False(suite.T(), selector.Match([]any{"id"}))
+}
+
+// TestZeroSelector tests that the zero Selector matches everything
+func (suite *SelectorTestSuite) TestZeroSelector() {
+	assert.True(suite.T(), Selector{}.Match(nil))
+	assert.True(suite.T(), Selector{}.Match([]any{"a", 1}))
+}
+
+// TestParseErrors tests that malformed expressions are rejected
+func (suite *SelectorTestSuite) TestParseErrors() {
+	for _, expression := range []string{"", "$.", "$[", "$[x]", "$[-1]", "$['a]", "$a", "a..", "$.a[0"} {
+		_, err := ParseSelector(expression)
+		assert.Error(suite.T(), err, "Expression %q", expression)
+	}
+}
+
+// TestSelector runs all selector tests
+func TestSelector(t *testing.T) {
+	suite.Run(t, new(SelectorTestSuite))
+}
@@ -0,0 +1,130 @@
+package structured
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Format is a structured data format whose string values can be converted
+type Format int
+
+const (
+	// FormatJSON is JSON
+	FormatJSON Format = iota
+	// FormatYAML is YAML, including multi-document streams
+	FormatYAML
+	// FormatTOML is TOML
+	FormatTOML
+)
+
+// String returns the name used for the format on the command line
+func (f Format) String() string {
+	switch f {
+	case FormatYAML:
+		return "yaml"
+	case FormatTOML:
+		return "toml"
+	default:
+		return "json"
+	}
+}
+
+// ParseFormat converts a format name into a Format
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "json":
+		return FormatJSON, nil
+	case "yaml", "yml":
+		return FormatYAML, nil
+	case "toml":
+		return FormatTOML, nil
+	default:
+		return FormatJSON, fmt.Errorf("unknown structured format %q (expected json, yaml or toml)", name)
+	}
+}
+
+// Transform applies convert to the string values of a document selected by selector
+//
+// Keys, numbers, comments, whitespace and key order are copied byte-for-byte,
+// as is every string convert leaves unchanged. A converted string keeps its
+// quoting style where the new value allows it, and is re-quoted otherwise, so
+// the result always parses to the same structure.
+func Transform(document string, format Format, selector Selector, convert func(string) string) (string, error) {
+	var edits []edit
+	var err error
+
+	switch format {
+	case FormatYAML:
+		edits, err = yamlEdits(document, selector, convert)
+	case FormatTOML:
+		edits, err = tomlEdits(document, selector, convert)
+	default:
+		edits, err = jsonEdits(document, selector, convert)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", strings.ToUpper(format.String()), err)
+	}
+
+	if len(edits) == 0 {
+		return document, nil
+	}
+
+	result := applyEdits(document, edits)
+	if err := validate(result, format); err != nil {
+		return "", fmt.Errorf("converting %s string values produced an invalid document: %w", strings.ToUpper(format.String()), err)
+	}
+
+	return result, nil
+}
+
+// edit replaces the bytes of a document between start and end
+type edit struct {
+	start, end int
+	text       string
+}
+
+// applyEdits returns document with the non-overlapping edits applied
+func applyEdits(document string, edits []edit) string {
+	slices.SortFunc(edits, func(a, b edit) int { return a.start - b.start })
+
+	var result strings.Builder
+	result.Grow(len(document))
+
+	previous := 0
+	for _, e := range edits {
+		result.WriteString(document[previous:e.start])
+		result.WriteString(e.text)
+		previous = e.end
+	}
+
+	result.WriteString(document[previous:])
+	return result.String()
+}
+
+// validate checks that a transformed document still parses
+func validate(document string, format Format) error {
+	switch format {
+	case FormatYAML:
+		return validateYAML(document)
+	case FormatTOML:
+		return validateTOML(document)
+	default:
+		return validateJSON(document)
+	}
+}
+
+// quoteJSON returns s as a JSON string literal, which is also a valid YAML
+// double-quoted scalar and TOML basic string
+func quoteJSON(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	// Encoding a string can't fail
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
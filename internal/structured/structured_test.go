@@ -0,0 +1,89 @@
+package structured
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// StructuredTestSuite defines the test suite for format-independent behavior
+type StructuredTestSuite struct {
+	suite.Suite
+}
+
+// TestParseFormat tests format names
+func (suite *StructuredTestSuite) TestParseFormat() {
+	tests := []struct {
+		name     string
+		expected Format
+	}{
+		{"json", FormatJSON},
+		{"YAML", FormatYAML},
+		{"yml", FormatYAML},
+		{"toml", FormatTOML},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			format, err := ParseFormat(tt.name)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, format)
+		})
+	}
+
+	_, err := ParseFormat("xml")
+	assert.Error(suite.T(), err)
+}
+
+// TestUnchangedDocuments tests that documents are returned as-is when no string changes
+func (suite *StructuredTestSuite) TestUnchangedDocuments() {
+	documents := map[Format]string{
+		FormatJSON: "{\r\n  \"b\" : [1, 2.50, true, null],\n\t\"a\":\"\\u0041\"\n}\n",
+		FormatYAML: "# comment\nb:   [1, 2]\na: 'x'   # trailing\n---\nlast: |\n  text\n",
+		FormatTOML: "# comment\nb = [ 1, 2 ]\n\n[table]\na   = 'x'  # trailing\n",
+	}
+
+	identity := func(text string) string { return text }
+	upper := strings.ToUpper
+
+	for format, document := range documents {
+		suite.Run(format.String(), func() {
+			result, err := Transform(document, format, Selector{}, identity)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), document, result)
+
+			// A selector matching nothing leaves every value alone
+			selector, err := ParseSelector("$.missing")
+			require.NoError(suite.T(), err)
+
+			result, err = Transform(document, format, selector, upper)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), document, result)
+		})
+	}
+}
+
+// TestInvalidDocuments tests that parse errors are reported with the format
+func (suite *StructuredTestSuite) TestInvalidDocuments() {
+	documents := map[Format]string{
+		FormatJSON: "{\"a\": }",
+		FormatYAML: "a: [b\n",
+		FormatTOML: "a = \n",
+	}
+
+	for format, document := range documents {
+		suite.Run(format.String(), func() {
+			_, err := Transform(document, format, Selector{}, strings.ToUpper)
+			require.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), "invalid "+strings.ToUpper(format.String()))
+		})
+	}
+}
+
+// TestStructured runs all format-independent tests
+func TestStructured(t *testing.T) {
+	suite.Run(t, new(StructuredTestSuite))
+}
@@ -0,0 +1,199 @@
+package structured
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+)
+
+// tomlWalker collects the edits for the strings of a TOML document
+type tomlWalker struct {
+	document string
+	selector Selector
+	convert  func(string) string
+	edits    []edit
+	// arrays holds the index of the current table of each array of tables
+	arrays map[string]int
+}
+
+// tomlEdits returns the edits converting the selected string values of a TOML document
+func tomlEdits(document string, selector Selector, convert func(string) string) ([]edit, error) {
+	// Unmarshal reports errors with their position, which the parser doesn't
+	if err := validateTOML(document); err != nil {
+		return nil, err
+	}
+
+	w := &tomlWalker{
+		document: document,
+		selector: selector,
+		convert:  convert,
+		arrays:   make(map[string]int),
+	}
+
+	var parser unstable.Parser
+	parser.Reset([]byte(document))
+
+	var table []any
+	for parser.NextExpression() {
+		expression := parser.Expression()
+
+		switch expression.Kind {
+		case unstable.Table:
+			table = w.resolve(tomlKeys(expression.Key()))
+		case unstable.ArrayTable:
+			keys := tomlKeys(expression.Key())
+			table = append(w.resolve(keys[:len(keys)-1]), keys[len(keys)-1])
+
+			id := pathID(table)
+			if index, ok := w.arrays[id]; ok {
+				w.arrays[id] = index + 1
+			} else {
+				w.arrays[id] = 0
+			}
+
+			table = append(table, w.arrays[id])
+		case unstable.KeyValue:
+			w.keyValue(expression, table)
+		}
+	}
+
+	if err := parser.Error(); err != nil {
+		return nil, err
+	}
+
+	return w.edits, nil
+}
+
+// resolve returns the path of a table key, including the current index of any array of tables it is inside
+func (w *tomlWalker) resolve(keys []string) []any {
+	var path []any
+	for _, key := range keys {
+		path = append(path, key)
+		if index, ok := w.arrays[pathID(path)]; ok {
+			path = append(path, index)
+		}
+	}
+
+	return path
+}
+
+// keyValue visits the value of a key/value pair in the table at path
+func (w *tomlWalker) keyValue(node *unstable.Node, path []any) {
+	keys := tomlKeys(node.Key())
+
+	valuePath := path[:len(path):len(path)]
+	for _, key := range keys {
+		valuePath = append(valuePath, key)
+	}
+
+	w.value(node.Value(), valuePath)
+}
+
+// value visits a value, recursing into arrays and inline tables
+func (w *tomlWalker) value(node *unstable.Node, path []any) {
+	switch node.Kind {
+	case unstable.String:
+		if w.selector.Match(path) {
+			w.convertString(node)
+		}
+	case unstable.Array:
+		index := 0
+		for children := node.Children(); children.Next(); {
+			if child := children.Node(); child.Kind != unstable.Comment {
+				w.value(child, append(path[:len(path):len(path)], index))
+				index++
+			}
+		}
+	case unstable.InlineTable:
+		for children := node.Children(); children.Next(); {
+			if child := children.Node(); child.Kind == unstable.KeyValue {
+				w.keyValue(child, path)
+			}
+		}
+	}
+}
+
+// convertString adds the edit for a string, keeping its quoting where the new value allows it
+func (w *tomlWalker) convertString(node *unstable.Node) {
+	value := string(node.Data)
+	converted := w.convert(value)
+	if converted == value {
+		return
+	}
+
+	start := int(node.Raw.Offset)
+	end := start + int(node.Raw.Length)
+	raw := w.document[start:end]
+	text := quoteTOML(converted)
+
+	switch {
+	case strings.HasPrefix(raw, "'''"):
+		// Converting the raw text keeps the line break trimmed after the opening delimiter
+		inner := w.convert(raw[3 : len(raw)-3])
+		if !strings.Contains(inner, "'''") && !hasTOMLControl(inner, true) {
+			text = "'''" + inner + "'''"
+		}
+	case strings.HasPrefix(raw, `"""`):
+		inner := w.convert(raw[3 : len(raw)-3])
+		if !strings.Contains(raw, `\`) && !strings.Contains(inner, `\`) && !strings.Contains(inner, `"""`) && !hasTOMLControl(inner, true) {
+			text = `"""` + inner + `"""`
+		}
+	case strings.HasPrefix(raw, "'"):
+		if !strings.Contains(converted, "'") && !hasTOMLControl(converted, false) {
+			text = "'" + converted + "'"
+		}
+	}
+
+	w.edits = append(w.edits, edit{start: start, end: end, text: text})
+}
+
+// tomlKeys returns the decoded parts of a dotted key
+func tomlKeys(parts unstable.Iterator) []string {
+	var keys []string
+	for parts.Next() {
+		keys = append(keys, string(parts.Node().Data))
+	}
+
+	return keys
+}
+
+// pathID returns a map key identifying a path
+func pathID(path []any) string {
+	var id strings.Builder
+	for _, element := range path {
+		switch element := element.(type) {
+		case string:
+			id.WriteString("." + strconv.Quote(element))
+		case int:
+			id.WriteString("[" + strconv.Itoa(element) + "]")
+		}
+	}
+
+	return id.String()
+}
+
+// hasTOMLControl reports whether s contains characters that must be escaped in a TOML string
+func hasTOMLControl(s string, multiline bool) bool {
+	return strings.ContainsFunc(s, func(r rune) bool {
+		if r == '\t' || multiline && (r == '\n' || r == '\r') {
+			return false
+		}
+
+		return unicode.IsControl(r)
+	})
+}
+
+// quoteTOML returns s as a TOML basic string
+func quoteTOML(s string) string {
+	// JSON escapes are valid in TOML, but JSON leaves DEL unescaped
+	return strings.ReplaceAll(quoteJSON(s), "\x7f", `\u007f`)
+}
+
+// validateTOML checks that document is a valid TOML document
+func validateTOML(document string) error {
+	var value map[string]any
+	return toml.Unmarshal([]byte(document), &value)
+}
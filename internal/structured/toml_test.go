@@ -0,0 +1,112 @@
+package structured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// TOMLTestSuite defines the test suite for TOML documents
+type TOMLTestSuite struct {
+	suite.Suite
+	processor *emojify.Processor
+}
+
+// SetupTest creates a processor for each test
+func (suite *TOMLTestSuite) SetupTest() {
+	suite.processor = emojify.NewProcessor()
+}
+
+// TestTransform tests that only string values are converted, keeping their quoting
+func (suite *TOMLTestSuite) TestTransform() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "basic and literal strings",
+			input:    "# :bug:\n\":bug:\" = \"Ship :rocket:\"\nliteral = ':tada:' # :x:\n",
+			expected: "# :bug:\n\":bug:\" = \"Ship 🚀\"\nliteral = '🎉' # :x:\n",
+		},
+		{
+			name:     "multi-line strings",
+			input:    "a = \"\"\"\nFixed :bug:\n\"\"\"\nb = '''\n:fire: \\n'''\n",
+			expected: "a = \"\"\"\nFixed 🐛\n\"\"\"\nb = '''\n🔥 \\n'''\n",
+		},
+		{
+			name:     "escaped strings",
+			input:    "a = \"say \\\"hi\\\" :wave:\"\n",
+			expected: "a = \"say \\\"hi\\\" 👋\"\n",
+		},
+		{
+			name:     "arrays and inline tables",
+			input:    "a = [\":bug:\", 1, { b = \":fire:\" }]\n",
+			expected: "a = [\"🐛\", 1, { b = \"🔥\" }]\n",
+		},
+		{
+			name:     "tables and dotted keys",
+			input:    "[server]\nmotd.text = \":tada:\"\nport = 80\n",
+			expected: "[server]\nmotd.text = \"🎉\"\nport = 80\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := Transform(tt.input, FormatTOML, Selector{}, suite.processor.Process)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result)
+		})
+	}
+}
+
+// TestSelector tests paths through tables and arrays of tables
+func (suite *TOMLTestSuite) TestSelector() {
+	input := "[[release]]\nnotes = \":bug:\"\n[release.meta]\nby = \":x:\"\n\n[[release]]\nnotes = \":fire:\"\n[release.meta]\nby = \":tada:\"\n"
+
+	tests := []struct {
+		name     string
+		selector string
+		expected string
+	}{
+		{
+			name:     "index of array of tables",
+			selector: "$.release[1].notes",
+			expected: "[[release]]\nnotes = \":bug:\"\n[release.meta]\nby = \":x:\"\n\n[[release]]\nnotes = \"🔥\"\n[release.meta]\nby = \":tada:\"\n",
+		},
+		{
+			name:     "sub-table of array of tables",
+			selector: "$.release[0].meta",
+			expected: "[[release]]\nnotes = \":bug:\"\n[release.meta]\nby = \"❌\"\n\n[[release]]\nnotes = \":fire:\"\n[release.meta]\nby = \":tada:\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			selector, err := ParseSelector(tt.selector)
+			require.NoError(suite.T(), err)
+
+			result, err := Transform(input, FormatTOML, selector, suite.processor.Process)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result)
+		})
+	}
+}
+
+// TestRequoting tests that values no longer valid in their quoting become basic strings
+func (suite *TOMLTestSuite) TestRequoting() {
+	quote := func(text string) string { return text + ` it's "\" ` }
+
+	result, err := Transform("a = 'x'\nb = '''y'''\n", FormatTOML, Selector{}, quote)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "a = \"x it's \\\"\\\\\\\" \"\nb = '''y it's \"\\\" '''\n", result)
+}
+
+// TestTOML runs all TOML tests
+func TestTOML(t *testing.T) {
+	suite.Run(t, new(TOMLTestSuite))
+}
@@ -0,0 +1,269 @@
+package structured
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlWalker collects the edits for the string scalars of a YAML stream
+type yamlWalker struct {
+	document   string
+	lineStarts []int
+	selector   Selector
+	convert    func(string) string
+	edits      []edit
+}
+
+// yamlEdits returns the edits converting the selected string values of a YAML stream
+func yamlEdits(document string, selector Selector, convert func(string) string) ([]edit, error) {
+	w := &yamlWalker{
+		document:   document,
+		lineStarts: lineStarts(document),
+		selector:   selector,
+		convert:    convert,
+	}
+
+	decoder := yaml.NewDecoder(strings.NewReader(document))
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				return w.edits, nil
+			}
+
+			return nil, err
+		}
+
+		// Selectors apply to each document of a stream
+		if err := w.walk(&node, nil, false); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// walk visits a node and its children, tracking the path and whether they are in a flow collection
+func (w *yamlWalker) walk(node *yaml.Node, path []any, flow bool) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			if err := w.walk(child, path, false); err != nil {
+				return err
+			}
+		}
+	case yaml.MappingNode:
+		flow = flow || node.Style&yaml.FlowStyle != 0
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if err := w.walk(node.Content[i+1], append(path[:len(path):len(path)], key), flow); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode:
+		flow = flow || node.Style&yaml.FlowStyle != 0
+		for i, child := range node.Content {
+			if err := w.walk(child, append(path[:len(path):len(path)], i), flow); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		// Aliases aren't followed; the anchored scalar is converted where it is defined
+		if node.ShortTag() == "!!str" && w.selector.Match(path) {
+			return w.convertScalar(node, flow)
+		}
+	}
+
+	return nil
+}
+
+// convertScalar adds the edit for a string scalar, keeping its style where possible
+func (w *yamlWalker) convertScalar(node *yaml.Node, flow bool) error {
+	converted := w.convert(node.Value)
+	if converted == node.Value {
+		return nil
+	}
+
+	start := skipYAMLProperties(w.document, w.offset(node.Line, node.Column))
+
+	switch {
+	case node.Style&yaml.DoubleQuotedStyle != 0:
+		end := endOfDoubleQuoted(w.document, start)
+		w.edits = append(w.edits, edit{start: start, end: end, text: quoteJSON(converted)})
+	case node.Style&yaml.SingleQuotedStyle != 0:
+		end := endOfSingleQuoted(w.document, start)
+		w.edits = append(w.edits, edit{start: start, end: end, text: quoteSingle(converted)})
+	case node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0:
+		w.convertBlock(start)
+	default:
+		end := start + len(node.Value)
+		if end > len(w.document) || w.document[start:end] != node.Value {
+			return fmt.Errorf("line %d: can't convert a plain scalar spanning several lines; quote it instead", node.Line)
+		}
+
+		w.edits = append(w.edits, edit{start: start, end: end, text: quotePlain(converted, flow)})
+	}
+
+	return nil
+}
+
+// convertBlock adds the edits for the content lines of a literal or folded block scalar whose header starts at start
+func (w *yamlWalker) convertBlock(start int) {
+	lineEnd := func(from int) int {
+		if i := strings.IndexByte(w.document[from:], '\n'); i >= 0 {
+			return from + i
+		}
+
+		return len(w.document)
+	}
+
+	indent := -1
+	for pos := lineEnd(start) + 1; pos < len(w.document); pos = lineEnd(pos) + 1 {
+		line := strings.TrimSuffix(w.document[pos:lineEnd(pos)], "\r")
+		content := strings.TrimLeft(line, " ")
+		if content == "" {
+			continue
+		}
+
+		lineIndent := len(line) - len(content)
+		if indent < 0 {
+			indent = lineIndent
+		}
+
+		if lineIndent < indent || indent == 0 && (strings.HasPrefix(line, "---") || strings.HasPrefix(line, "...")) {
+			return
+		}
+
+		text := line[indent:]
+		if converted := w.convert(text); converted != text {
+			w.edits = append(w.edits, edit{start: pos + indent, end: pos + len(line), text: converted})
+		}
+	}
+}
+
+// offset returns the byte offset of a 1-based line and character column
+func (w *yamlWalker) offset(line, column int) int {
+	if line < 1 || line > len(w.lineStarts) {
+		return len(w.document)
+	}
+
+	pos := w.lineStarts[line-1]
+	for range column - 1 {
+		_, size := utf8.DecodeRuneInString(w.document[pos:])
+		pos += size
+	}
+
+	return pos
+}
+
+// lineStarts returns the byte offset of the start of each line
+func lineStarts(document string) []int {
+	starts := []int{0}
+	for i := range len(document) {
+		if document[i] == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
+
+// skipYAMLProperties skips the anchor and tag before a node's content
+func skipYAMLProperties(document string, pos int) int {
+	for pos < len(document) && (document[pos] == '&' || document[pos] == '!') {
+		for pos < len(document) && !strings.ContainsRune(" \t\r\n", rune(document[pos])) {
+			pos++
+		}
+
+		for pos < len(document) && strings.ContainsRune(" \t\r\n", rune(document[pos])) {
+			pos++
+		}
+	}
+
+	return pos
+}
+
+// endOfDoubleQuoted returns the offset just past the double-quoted scalar starting at start
+func endOfDoubleQuoted(document string, start int) int {
+	return endOfJSONString(document, start)
+}
+
+// endOfSingleQuoted returns the offset just past the single-quoted scalar starting at start
+func endOfSingleQuoted(document string, start int) int {
+	for i := start + 1; i < len(document); i++ {
+		if document[i] != '\'' {
+			continue
+		}
+
+		if i+1 < len(document) && document[i+1] == '\'' {
+			i++
+			continue
+		}
+
+		return i + 1
+	}
+
+	return len(document)
+}
+
+// quoteSingle returns s as a single-quoted scalar, or double-quoted if it can't be written single-quoted
+func quoteSingle(s string) string {
+	if strings.ContainsFunc(s, func(r rune) bool { return r == '\n' || unicode.IsControl(r) && r != '\t' }) {
+		return quoteJSON(s)
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// quotePlain returns s as a plain scalar, or double-quoted if it would read as something else
+func quotePlain(s string, flow bool) string {
+	// Marshal escapes emoji, so check the plain form reads back as the same string instead
+	probe := "k: " + s
+	if flow {
+		probe = "k: [" + s + "]"
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(probe), &document); err != nil || len(document.Content) != 1 {
+		return quoteJSON(s)
+	}
+
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode || len(mapping.Content) != 2 {
+		return quoteJSON(s)
+	}
+
+	value := mapping.Content[1]
+	if flow {
+		if value.Kind != yaml.SequenceNode || len(value.Content) != 1 {
+			return quoteJSON(s)
+		}
+
+		value = value.Content[0]
+	}
+
+	if value.Kind != yaml.ScalarNode || value.Style != 0 || value.ShortTag() != "!!str" || value.Value != s {
+		return quoteJSON(s)
+	}
+
+	return s
+}
+
+// validateYAML checks that every document of a YAML stream parses
+func validateYAML(document string) error {
+	decoder := yaml.NewDecoder(strings.NewReader(document))
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package structured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/damienbutt/emojify-go/internal/emojify"
+)
+
+// YAMLTestSuite defines the test suite for YAML documents
+type YAMLTestSuite struct {
+	suite.Suite
+	processor *emojify.Processor
+}
+
+// SetupTest creates a processor for each test
+func (suite *YAMLTestSuite) SetupTest() {
+	suite.processor = emojify.NewProcessor()
+}
+
+// TestTransform tests that only string values are converted, keeping their style
+func (suite *YAMLTestSuite) TestTransform() {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain scalars and comments",
+			input:    "# :bug:\n\":bug:\": Ship :rocket:x # :fire:\ncount: 3\n",
+			expected: "# :bug:\n\":bug:\": Ship 🚀x # :fire:\ncount: 3\n",
+		},
+		{
+			name:     "quoted scalars",
+			input:    "a: \":tada:\"\nb: 'it''s :bug:'\nc: \"tab\\t:fire:\"\n",
+			expected: "a: \"🎉\"\nb: 'it''s 🐛'\nc: \"tab\\t🔥\"\n",
+		},
+		{
+			name:     "sequences and flow collections",
+			input:    "list:\n  - \":bug:\"\n  - [a :x:b, 2, {k: \":fire:\"}]\n",
+			expected: "list:\n  - \"🐛\"\n  - [a ❌b, 2, {k: \"🔥\"}]\n",
+		},
+		{
+			name:     "block scalars",
+			input:    "notes: |\n  Fixed :bug:\n\n  Shipped :rocket:\nfolded: >-\n    :tada:\nnext: \":x:\"\n",
+			expected: "notes: |\n  Fixed 🐛\n\n  Shipped 🚀\nfolded: >-\n    🎉\nnext: \"❌\"\n",
+		},
+		{
+			name:     "anchors, aliases and tags",
+			input:    "a: &x \":bug:\"\nb: *x\nc: !!str :fire:x\n",
+			expected: "a: &x \"🐛\"\nb: *x\nc: !!str 🔥x\n",
+		},
+		{
+			name:     "multiple documents",
+			input:    "title: \":bug:\"\n---\ntitle: \":fire:\"\n",
+			expected: "title: \"🐛\"\n---\ntitle: \"🔥\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := Transform(tt.input, FormatYAML, Selector{}, suite.processor.Process)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result)
+		})
+	}
+}
+
+// TestRequoting tests that values no longer valid in their style are quoted
+func (suite *YAMLTestSuite) TestRequoting() {
+	tests := []struct {
+		name     string
+		input    string
+		suffix   string
+		expected string
+	}{
+		{
+			name:     "plain value becoming a comment",
+			input:    "a: x\n",
+			suffix:   " # y",
+			expected: "a: \"x # y\"\n",
+		},
+		{
+			name:     "plain value in a flow sequence gaining a comma",
+			input:    "a: [x]\n",
+			suffix:   ", y",
+			expected: "a: [\"x, y\"]\n",
+		},
+		{
+			name:     "single-quoted value gaining a newline",
+			input:    "a: 'x'\n",
+			suffix:   "\ny",
+			expected: "a: \"x\\ny\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suffix := func(text string) string { return text + tt.suffix }
+
+			result, err := Transform(tt.input, FormatYAML, Selector{}, suffix)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, result)
+		})
+	}
+}
+
+// TestSelector tests that only selected values are converted
+func (suite *YAMLTestSuite) TestSelector() {
+	input := "releases:\n  - notes: \":bug:\"\n    name: \":bug:\"\n  - notes: \":fire:\"\n"
+
+	selector, err := ParseSelector("notes")
+	require.NoError(suite.T(), err)
+
+	result, err := Transform(input, FormatYAML, selector, suite.processor.Process)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "releases:\n  - notes: \"🐛\"\n    name: \":bug:\"\n  - notes: \"🔥\"\n", result)
+}
+
+// TestMultilinePlainScalar tests that a plain scalar spanning lines is reported rather than corrupted
+func (suite *YAMLTestSuite) TestMultilinePlainScalar() {
+	_, err := Transform("a: first :bug:x\n  second\n", FormatYAML, Selector{}, suite.processor.Process)
+	require.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "line 1")
+}
+
+// TestYAML runs all YAML tests
+func TestYAML(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
@@ -5,6 +5,7 @@
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -792,6 +793,739 @@ func (suite *IntegrationTestSuite) TestFlagsBytePreservation() {
 	}
 }
 
+// TestMaxUnicodeFlag tests the --max-unicode and --unicode-fallback flags
+func (suite *IntegrationTestSuite) TestMaxUnicodeFlag() {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "newer emoji left as alias",
+			args:     []string{"--max-unicode", "13.0", "Melting :melting_face: :rocket:"},
+			expected: "Melting :melting_face: 🚀\n",
+		},
+		{
+			name:     "newer emoji replaced with fallback",
+			args:     []string{"--max-unicode", "13.0", "--unicode-fallback", "?", "Melting :melting_face:"},
+			expected: "Melting ?\n",
+		},
+		{
+			name:     "emoji within the limit",
+			args:     []string{"--max-unicode", "14.0", "Melting :melting_face:"},
+			expected: "Melting 🫠\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			cmd := exec.Command(suite.binaryPath, tt.args...)
+			output, err := cmd.Output()
+
+			require.NoError(suite.T(), err, "Command should not fail")
+			assert.Equal(suite.T(), tt.expected, string(output))
+		})
+	}
+
+	for _, version := range []string{"latest", "NaN", "Inf", "1e3"} {
+		cmd := exec.Command(suite.binaryPath, "--max-unicode", version, "text")
+		output, err := cmd.CombinedOutput()
+		assert.Error(suite.T(), err, "Invalid version %q should be rejected", version)
+		assert.Contains(suite.T(), string(output), "invalid --max-unicode version")
+	}
+}
+
+// TestPresentationFlag tests forcing emoji or text presentation
+func (suite *IntegrationTestSuite) TestPresentationFlag() {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "emoji presentation",
+			args:     []string{"--presentation", "emoji", ":airplane:"},
+			expected: "✈️\n",
+		},
+		{
+			name:     "text presentation",
+			args:     []string{"--presentation", "text", ":airplane: :smile:"},
+			expected: "✈︎ 😄\n",
+		},
+		{
+			name:     "strip selectors",
+			args:     []string{"--presentation", "strip", ":airplane:"},
+			expected: "✈\n",
+		},
+		{
+			name:     "decode accepts text presentation",
+			args:     []string{"--decode", "✈︎"},
+			expected: ":airplane:\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			cmd := exec.Command(suite.binaryPath, tt.args...)
+			output, err := cmd.Output()
+
+			require.NoError(suite.T(), err, "Command should not fail")
+			assert.Equal(suite.T(), tt.expected, string(output))
+		})
+	}
+
+	cmd := exec.Command(suite.binaryPath, "--presentation", "colour", "text")
+	_, err := cmd.CombinedOutput()
+	assert.Error(suite.T(), err, "Unknown presentations should be rejected")
+}
+
+// TestSkinToneFlag tests default skin tones and gender from flags and config
+func (suite *IntegrationTestSuite) TestSkinToneFlag() {
+	configHome := suite.T().TempDir()
+	configPath := filepath.Join(configHome, "emojify.json")
+	require.NoError(suite.T(), os.WriteFile(configPath, []byte(`{"skin_tone": 5, "gender": "female"}`), 0o644))
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "skin tone flag",
+			args:     []string{"--skin-tone", "3", ":wave: :smile:"},
+			expected: "👋🏽 😄\n",
+		},
+		{
+			name:     "inline modifiers",
+			args:     []string{":wave::skin-tone-2: :ok_hand_tone4:"},
+			expected: "👋🏻 👌🏾\n",
+		},
+		{
+			name:     "gender flag",
+			args:     []string{"--gender", "male", ":technologist:"},
+			expected: "👨‍💻\n",
+		},
+		{
+			name:     "config file",
+			args:     []string{"--config", configPath, ":wave: :runner:"},
+			expected: "👋🏿 🏃🏿‍♀️\n",
+		},
+		{
+			name:     "flags override config",
+			args:     []string{"--config", configPath, "--skin-tone", "light", "--gender", "neutral", ":runner:"},
+			expected: "🏃🏻\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			cmd := exec.Command(suite.binaryPath, tt.args...)
+			cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configHome)
+			output, err := cmd.Output()
+
+			require.NoError(suite.T(), err, "Command should not fail")
+			assert.Equal(suite.T(), tt.expected, string(output))
+		})
+	}
+
+	cmd := exec.Command(suite.binaryPath, "--skin-tone", "6", ":wave:")
+	output, err := cmd.CombinedOutput()
+	assert.Error(suite.T(), err, "Invalid skin tones should be rejected")
+	assert.Contains(suite.T(), string(output), "unknown skin tone")
+}
+
+// TestGitHook tests installing, running and removing the commit message hook
+func (suite *IntegrationTestSuite) TestGitHook() {
+	if _, err := exec.LookPath("git"); err != nil {
+		suite.T().Skip("git is not installed")
+	}
+
+	binaryPath, err := filepath.Abs(suite.binaryPath)
+	require.NoError(suite.T(), err)
+
+	repo := suite.T().TempDir()
+	env := append(os.Environ(),
+		"XDG_CONFIG_HOME="+suite.T().TempDir(),
+		"GIT_AUTHOR_NAME=emojify", "GIT_AUTHOR_EMAIL=emojify@example.com",
+		"GIT_COMMITTER_NAME=emojify", "GIT_COMMITTER_EMAIL=emojify@example.com",
+	)
+
+	run := func(name string, args ...string) (string, error) {
+		cmd := exec.Command(name, args...)
+		cmd.Dir = repo
+		cmd.Env = env
+		output, err := cmd.CombinedOutput()
+		return string(output), err
+	}
+
+	commit := func(message string) (string, error) {
+		return run("git", "commit", "--allow-empty", "-q", "-m", message)
+	}
+
+	lastMessage := func() string {
+		output, err := run("git", "log", "-1", "--format=%s")
+		require.NoError(suite.T(), err, output)
+		return strings.TrimSpace(output)
+	}
+
+	_, err = run("git", "init", "-q")
+	require.NoError(suite.T(), err)
+
+	// An existing hook is chained and still runs
+	chainLog := filepath.Join(repo, "chained.log")
+	userHook := "#!/bin/sh\necho ran >> '" + chainLog + "'\n"
+	require.NoError(suite.T(), os.WriteFile(filepath.Join(repo, ".git", "hooks", "commit-msg"), []byte(userHook), 0o755))
+
+	output, err := run(binaryPath, "git", "install-hook")
+	require.NoError(suite.T(), err, output)
+
+	output, err = commit(":sparkles: add feature :rocket:")
+	require.NoError(suite.T(), err, output)
+	assert.Equal(suite.T(), "✨ add feature 🚀", lastMessage())
+	assert.FileExists(suite.T(), chainLog, "The original hook should run")
+
+	output, err = run(binaryPath, "git", "install-hook", "--mode", "decode")
+	require.NoError(suite.T(), err, output)
+
+	output, err = commit("🐛 fix bug")
+	require.NoError(suite.T(), err, output)
+	assert.Equal(suite.T(), ":bug: fix bug", lastMessage())
+
+	output, err = run(binaryPath, "git", "install-hook", "--mode", "lint")
+	require.NoError(suite.T(), err, output)
+
+	output, err = commit(":rocekt: launch")
+	assert.Error(suite.T(), err, "Unknown aliases should abort the commit")
+	assert.Contains(suite.T(), output, "did you mean :rocket:?")
+
+	output, err = run(binaryPath, "git", "uninstall-hook")
+	require.NoError(suite.T(), err, output)
+
+	hook, err := os.ReadFile(filepath.Join(repo, ".git", "hooks", "commit-msg"))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), userHook, string(hook), "The original hook should be restored")
+}
+
+// TestConventionalCommits tests gitmoji prefixes, the commit helper and config overrides
+func (suite *IntegrationTestSuite) TestConventionalCommits() {
+	configHome := suite.T().TempDir()
+	configPath := filepath.Join(configHome, "emojify.json")
+	require.NoError(suite.T(), os.WriteFile(configPath, []byte(`{"commit_types": {"chore": ":hammer:", "deps": "⬆️"}}`), 0o644))
+
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		expected string
+	}{
+		{
+			name:     "conventional flag",
+			args:     []string{"--conventional"},
+			input:    "1a2b3c4 feat: add search\n5d6e7f8 fix(api): handle :x:\n",
+			expected: "1a2b3c4 ✨ feat: add search\n5d6e7f8 🐛 fix(api): handle ❌\n",
+		},
+		{
+			name:     "config overrides",
+			args:     []string{"--conventional", "--config", configPath},
+			input:    "chore: tidy\ndeps: bump\n",
+			expected: "🔨 chore: tidy\n⬆️ deps: bump\n",
+		},
+		{
+			name:     "commit dry run",
+			args:     []string{"commit", "--dry-run", "-t", "feat", "-s", "cli", "-m", "add :mag: search"},
+			expected: "✨ feat(cli): add 🔍 search\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			cmd := exec.Command(suite.binaryPath, tt.args...)
+			cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configHome)
+			cmd.Stdin = strings.NewReader(tt.input)
+			output, err := cmd.Output()
+
+			require.NoError(suite.T(), err, "Command should not fail")
+			assert.Equal(suite.T(), tt.expected, string(output))
+		})
+	}
+
+	cmd := exec.Command(suite.binaryPath, "commit", "--dry-run", "-t", "feature", "-m", "x")
+	output, err := cmd.CombinedOutput()
+	assert.Error(suite.T(), err, "Unknown commit types should be rejected")
+	assert.Contains(suite.T(), string(output), "unknown commit type")
+
+	if _, err := exec.LookPath("git"); err != nil {
+		return
+	}
+
+	repo := suite.T().TempDir()
+	binaryPath, err := filepath.Abs(suite.binaryPath)
+	require.NoError(suite.T(), err)
+
+	for _, args := range [][]string{
+		{"git", "init", "-q"},
+		{binaryPath, "commit", "-m", "docs: update README", "--", "--allow-empty", "-q"},
+		{"git", "log", "-1", "--format=%s"},
+	} {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(),
+			"XDG_CONFIG_HOME="+configHome,
+			"GIT_AUTHOR_NAME=emojify", "GIT_AUTHOR_EMAIL=emojify@example.com",
+			"GIT_COMMITTER_NAME=emojify", "GIT_COMMITTER_EMAIL=emojify@example.com",
+		)
+		output, err = cmd.CombinedOutput()
+		require.NoError(suite.T(), err, string(output))
+	}
+
+	assert.Equal(suite.T(), "📝 docs: update README\n", string(output))
+}
+
+// TestSearchAndInfo tests the search and info commands
+func (suite *IntegrationTestSuite) TestSearchAndInfo() {
+	output, err := exec.Command(suite.binaryPath, "search", "--limit", "1", "new", "features").Output()
+	require.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(output), ":sparkles:")
+	assert.Contains(suite.T(), string(output), "gitmoji: Introduce new features")
+
+	output, err = exec.Command(suite.binaryPath, "info", "🐛").Output()
+	require.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(output), "Gitmoji:     Fix a bug")
+
+	output, err = exec.Command(suite.binaryPath, "info", "--json", "rocket").Output()
+	require.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(output), `"gitmoji": "Deploy stuff"`)
+
+	output, err = exec.Command(suite.binaryPath, "info", ":rocekt:").CombinedOutput()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), string(output), "did you mean :rocket:?")
+}
+
+// TestGitFilter tests storing aliases and checking out emoji through the filter process
+func (suite *IntegrationTestSuite) TestGitFilter() {
+	if _, err := exec.LookPath("git"); err != nil {
+		suite.T().Skip("git is not installed")
+	}
+
+	binaryPath, err := filepath.Abs(suite.binaryPath)
+	require.NoError(suite.T(), err)
+
+	// Prefixes turned on in the config file apply to the command line, not to the filter
+	configHome := suite.T().TempDir()
+	require.NoError(suite.T(), os.MkdirAll(filepath.Join(configHome, "emojify"), 0o755))
+	require.NoError(suite.T(), os.WriteFile(filepath.Join(configHome, "emojify", "config.json"), []byte(`{"conventional_commits": true}`), 0o644))
+
+	repo := suite.T().TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(),
+			"XDG_CONFIG_HOME="+configHome,
+			"GIT_AUTHOR_NAME=emojify", "GIT_AUTHOR_EMAIL=emojify@example.com",
+			"GIT_COMMITTER_NAME=emojify", "GIT_COMMITTER_EMAIL=emojify@example.com",
+		)
+		output, err := cmd.CombinedOutput()
+		require.NoError(suite.T(), err, string(output))
+		return string(output)
+	}
+
+	git("init", "-q")
+	git("config", "filter.emojify.process", "'"+filepath.ToSlash(binaryPath)+"' git-filter --clean decode --smudge encode")
+	git("config", "filter.emojify.required", "true")
+
+	files := map[string]string{
+		".gitattributes": "*.md filter=emojify\n",
+		"README.md":      "Deploy 🚀 done 🎉\nfeat: add search\n",
+		"notes.txt":      "Untouched 🚀\n",
+	}
+
+	for name, content := range files {
+		require.NoError(suite.T(), os.WriteFile(filepath.Join(repo, name), []byte(content), 0o644))
+	}
+
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+
+	assert.Equal(suite.T(), "Deploy :rocket: done :tada:\nfeat: add search\n", git("show", "HEAD:README.md"), "Files should be stored with aliases")
+	assert.Equal(suite.T(), "Untouched 🚀\n", git("show", "HEAD:notes.txt"), "Files without the attribute are unchanged")
+
+	require.NoError(suite.T(), os.Remove(filepath.Join(repo, "README.md")))
+	git("checkout", "--", "README.md")
+
+	content, err := os.ReadFile(filepath.Join(repo, "README.md"))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Deploy 🚀 done 🎉\nfeat: add search\n", string(content), "Files should be checked out with emoji")
+	assert.Empty(suite.T(), git("status", "--porcelain"))
+}
+
+// TestPandocFilter tests converting a pandoc JSON document, as pandoc runs filters
+func (suite *IntegrationTestSuite) TestPandocFilter() {
+	document := `{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[` +
+		`{"t":"Para","c":[{"t":"Str","c":":"},{"t":"Str","c":"rocket:"}]},` +
+		`{"t":"CodeBlock","c":[["",[],[]],":rocket:"]}]}`
+
+	cmd := exec.Command(suite.binaryPath, "pandoc-filter", "html")
+	cmd.Stdin = strings.NewReader(document)
+	output, err := cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"pandoc-api-version":[1,23,1],"meta":{},"blocks":[`+
+		`{"t":"Para","c":[{"t":"Str","c":"🚀"}]},`+
+		`{"t":"CodeBlock","c":[["",[],[]],":rocket:"]}]}`, string(output))
+
+	cmd = exec.Command(suite.binaryPath, "pandoc-filter")
+	cmd.Stdin = strings.NewReader("# Not JSON")
+	assert.Error(suite.T(), cmd.Run())
+}
+
+// TestHTMLFlag tests HTML output from arguments, stdin and the config file
+func (suite *IntegrationTestSuite) TestHTMLFlag() {
+	output, err := exec.Command(suite.binaryPath, "--html", "<b>Ship</b> :rocket:").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `&lt;b&gt;Ship&lt;/b&gt; <img class="emoji" alt="🚀" src="https://cdn.jsdelivr.net/gh/jdecked/twemoji@latest/assets/72x72/1f680.png">`+"\n", string(output))
+
+	cmd := exec.Command(suite.binaryPath, "--html", "--html-tag", "span", "--html-class", "icon")
+	cmd.Stdin = strings.NewReader("Hi :wave:\n")
+	output, err = cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `Hi <span class="icon" role="img" aria-label="waving hand">👋</span>`+"\n", string(output))
+
+	configPath := filepath.Join(suite.T().TempDir(), "config.json")
+	require.NoError(suite.T(), os.WriteFile(configPath, []byte(`{"html_url": "/img/{{code}}.png", "html_naming": "noto"}`), 0o644))
+
+	output, err = exec.Command(suite.binaryPath, "--config", configPath, "--html", ":wave:").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `<img class="emoji" alt="👋" src="/img/emoji_u1f44b.png">`+"\n", string(output))
+
+	for _, args := range [][]string{
+		{"--html", "--decode", "🚀"},
+		{"--html", "--html-url", "/img/rocket.png", ":rocket:"},
+		{"--html", "--html-tag", "div", ":rocket:"},
+	} {
+		assert.Error(suite.T(), exec.Command(suite.binaryPath, args...).Run(), "Args %q", args)
+	}
+}
+
+// TestInputHTML tests converting only the text of HTML documents
+func (suite *IntegrationTestSuite) TestInputHTML() {
+	document := "<p title=\":rocket:\">Tom &amp; Jerry :heart:</p>\n<script>x = a ? b :c:;</script><pre>:bug:</pre>\n"
+
+	cmd := exec.Command(suite.binaryPath, "--input", "html")
+	cmd.Stdin = strings.NewReader(document)
+	output, err := cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "<p title=\":rocket:\">Tom &amp; Jerry ❤️</p>\n<script>x = a ? b :c:;</script><pre>:bug:</pre>\n", string(output))
+
+	cmd = exec.Command(suite.binaryPath, "--input", "html", "--decode")
+	cmd.Stdin = strings.NewReader("<p>🚀</p><code>🚀</code>")
+	output, err = cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "<p>:rocket:</p><code>🚀</code>", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--input", "html", "--html", "--html-tag", "span", "<b>&lt;:+1:&gt;</b>").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `<b>&lt;<span class="emoji" role="img" aria-label="thumbs up">👍</span>&gt;</b>`+"\n", string(output))
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--input", "xml", ":rocket:").Run())
+}
+
+// TestInputStructured tests converting only the string values of JSON, YAML and TOML
+func (suite *IntegrationTestSuite) TestInputStructured() {
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		expected string
+	}{
+		{
+			name:     "json",
+			args:     []string{"--input", "json"},
+			input:    "{\n  \":bug:\": \":bug:\",\n  \"n\": 1\n}\n",
+			expected: "{\n  \":bug:\": \"🐛\",\n  \"n\": 1\n}\n",
+		},
+		{
+			name:     "yaml with selector",
+			args:     []string{"--input", "yaml", "--select", "notes"},
+			input:    "# :bug:\nname: \":bug:\"\nnotes: Fixed :bug:x\n",
+			expected: "# :bug:\nname: \":bug:\"\nnotes: Fixed 🐛x\n",
+		},
+		{
+			name:     "toml with several selectors",
+			args:     []string{"--input", "toml", "--select", "$.a", "--select", "$.c[1]"},
+			input:    "a = ':x:'\nb = ':x:'\nc = [':x:', ':x:']\n",
+			expected: "a = '❌'\nb = ':x:'\nc = [':x:', '❌']\n",
+		},
+		{
+			name:     "decode",
+			args:     []string{"--input", "json", "--decode"},
+			input:    `["🚀"]`,
+			expected: `[":rocket:"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			cmd := exec.Command(suite.binaryPath, tt.args...)
+			cmd.Stdin = strings.NewReader(tt.input)
+			output, err := cmd.Output()
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected, string(output))
+		})
+	}
+
+	cmd := exec.Command(suite.binaryPath, "--input", "json")
+	cmd.Stdin = strings.NewReader("{\"a\": :rocket:}")
+	output, err := cmd.CombinedOutput()
+	require.Error(suite.T(), err)
+	assert.Contains(suite.T(), string(output), "invalid JSON")
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--select", "$.a", ":rocket:").Run())
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--input", "json", "--select", "$[", "{}").Run())
+}
+
+// TestEscapeFlag tests writing emoji as ASCII escapes and decoding them
+func (suite *IntegrationTestSuite) TestEscapeFlag() {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"json", []string{"--escape", "json", "Ship :rocket:"}, "Ship \\ud83d\\ude80"},
+		{"go", []string{"--escape", "go", ":rocket:"}, "\\U0001F680"},
+		{"python", []string{"--escape", "python", ":rocket:"}, "\\U0001F680"},
+		{"html", []string{"--escape", "html", ":rocket:"}, "&#x1F680;"},
+		{"css", []string{"--escape", "css", ":rocket:"}, "\\1F680 "},
+		{"emoji in the text", []string{"--escape", "html", "👍🏽 :rocket:"}, "&#x1F44D;&#x1F3FD; &#x1F680;"},
+		{"decode json", []string{"--decode", "--escape", "json", "\\ud83d\\ude80 \\u00e9"}, ":rocket: \\u00e9"},
+		{"decode html", []string{"--decode", "--escape", "html", "&#128640;"}, ":rocket:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			output, err := exec.Command(suite.binaryPath, tt.args...).Output()
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected+"\n", string(output))
+		})
+	}
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--escape", "xml", ":rocket:").Run())
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--escape", "json", "--html", ":rocket:").Run())
+
+	cmd := exec.Command(suite.binaryPath, "--input", "json", "--escape", "json")
+	cmd.Stdin = strings.NewReader(`{"a": ":rocket:"}`)
+	assert.Error(suite.T(), cmd.Run(), "Structured input quotes values itself")
+
+	cmd = exec.Command(suite.binaryPath, "--input", "json", "--escape", "html")
+	cmd.Stdin = strings.NewReader(`{"a": ":rocket:"}`)
+	output, err := cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `{"a": "&#x1F680;"}`, string(output))
+}
+
+// TestDecodeEscapesFlag tests decoding emoji written as entities and escapes
+func (suite *IntegrationTestSuite) TestDecodeEscapesFlag() {
+	cmd := exec.Command(suite.binaryPath, "--decode", "--decode-escapes")
+	cmd.Stdin = strings.NewReader(`Ticket &#128640; &#x1F41B; \u{2728} \ud83c\udf89 &amp; \u00e9`)
+	output, err := cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `Ticket :rocket: :bug: :sparkles: :tada: &amp; \u00e9`, string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--decode", "&#128640;").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "&#128640;\n", string(output))
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--decode-escapes", "&#128640;").Run())
+}
+
+// TestFixColumnsFlag tests that aligned columns stay aligned after substitution
+func (suite *IntegrationTestSuite) TestFixColumnsFlag() {
+	cmd := exec.Command(suite.binaryPath, "--fix-columns")
+	cmd.Stdin = strings.NewReader("NAME      STATUS\n:rocket:  up\n:x:       down\n")
+	output, err := cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "NAME      STATUS\n🚀        up\n❌        down\n", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "info", "--json", "rocket").Output()
+	require.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(output), `"width": 2`)
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--decode", "--fix-columns", "🚀").Run())
+}
+
+// TestFallbackFlag tests writing aliases and emoji as plain text
+func (suite *IntegrationTestSuite) TestFallbackFlag() {
+	tests := []struct {
+		name     string
+		mode     string
+		expected string
+	}{
+		{"ascii", "ascii", "Thanks :) [rocket] [rocket]"},
+		{"text", "text", "Thanks [slightly_smiling_face] [rocket] [rocket]"},
+		{"strip", "strip", "Thanks   "},
+		{"description", "description", "Thanks (slightly smiling face) (rocket) (rocket)"},
+		{"none", "none", "Thanks 🙂 🚀 🚀"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			output, err := exec.Command(suite.binaryPath, "--fallback", tt.mode, "Thanks :slightly_smiling_face: 🚀 :rocket:").Output()
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expected+"\n", string(output))
+		})
+	}
+
+	// Output to a pipe keeps emoji whatever the locale
+	cmd := exec.Command(suite.binaryPath, ":rocket:")
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	output, err := cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "🚀\n", string(output))
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--fallback", "emoji", ":rocket:").Run())
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--decode", "--fallback", "text", "🚀").Run())
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--html", "--fallback", "text", ":rocket:").Run())
+}
+
+// TestEmoticonsFlag tests converting emoticons in both directions, with a config file
+func (suite *IntegrationTestSuite) TestEmoticonsFlag() {
+	output, err := exec.Command(suite.binaryPath, "--emoticons", "Thanks :) see a:) <3").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Thanks 🙂 see a:) ❤️\n", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--decode", "--emoticons", "Thanks 🙂 🚀").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Thanks :) :rocket:\n", string(output))
+
+	cmd := exec.Command(suite.binaryPath, "--decode", "--emoticons", "--input", "html")
+	cmd.Stdin = strings.NewReader("<p>I ❤️ you 💔 🙂</p>")
+	output, err = cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "<p>I &lt;3 you &lt;/3 :)</p>", string(output), "Emoticons are written as HTML text")
+
+	cmd = exec.Command(suite.binaryPath, "--emoticons", "--input", "html")
+	cmd.Stdin = strings.NewReader(string(output))
+	output, err = cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "<p>I ❤️ you 💔 🙂</p>", string(output))
+
+	configPath := filepath.Join(suite.T().TempDir(), "config.json")
+	require.NoError(suite.T(), os.WriteFile(configPath, []byte(`{"emoticons": {"\\o/": ":raised_hands:", ":)": ""}}`), 0o644))
+
+	output, err = exec.Command(suite.binaryPath, "--config", configPath, "--emoticons", "\\o/ :)").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "🙌 :)\n", string(output))
+
+	require.NoError(suite.T(), os.WriteFile(configPath, []byte(`{"emoticons": {"xx": ":unknown:"}}`), 0o644))
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--config", configPath, "--emoticons", ":)").Run())
+}
+
+// TestCustomEmojiFlag tests custom emoji from a registry file and Discord emoji
+func (suite *IntegrationTestSuite) TestCustomEmojiFlag() {
+	registry := filepath.Join(suite.T().TempDir(), "emoji.json")
+	require.NoError(suite.T(), os.WriteFile(registry, []byte(`{"partyparrot": "https://example.com/parrot.gif"}`), 0o644))
+
+	input := ":partyparrot: <:blobcat:123456789012345678> :tada:"
+
+	output, err := exec.Command(suite.binaryPath, "--custom-emoji", registry, input).Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ":partyparrot: <:blobcat:123456789012345678> 🎉\n", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--custom-emoji", registry, "--markdown", input).Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "![:partyparrot:](https://example.com/parrot.gif) ![:blobcat:](https://cdn.discordapp.com/emojis/123456789012345678.png) 🎉\n", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--custom-emoji", registry, "--html", ":partyparrot:").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "<img class=\"emoji\" alt=\":partyparrot:\" src=\"https://example.com/parrot.gif\">\n", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--decode", "<a:blobcat:123456789012345678> 🎉").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ":blobcat: :tada:\n", string(output))
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--custom-emoji", filepath.Join(suite.T().TempDir(), "missing.json"), ":tada:").Run())
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "--markdown", "--html", ":tada:").Run())
+}
+
+// TestLocaleFlag tests selecting the locale of localized aliases
+func (suite *IntegrationTestSuite) TestLocaleFlag() {
+	output, err := exec.Command(suite.binaryPath, "--locale", "en_US", ":rocket:").Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "🚀\n", string(output))
+
+	// A locale from the environment without a dictionary is ignored
+	cmd := exec.Command(suite.binaryPath, ":rocket:")
+	cmd.Env = append(os.Environ(), "LC_ALL=tlh_AQ.UTF-8")
+	output, err = cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "🚀\n", string(output))
+
+	output, err = exec.Command(suite.binaryPath, "--locale", "tlh", ":rocket:").CombinedOutput()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), string(output), `unknown locale "tlh"`)
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "search", "--locale", "tlh", "rocket").Run())
+}
+
+// TestLocaleDictionaries tests encoding localized aliases with the generated dictionaries
+func (suite *IntegrationTestSuite) TestLocaleDictionaries() {
+	output, err := exec.Command(suite.binaryPath, "--locale", "de", "Start :rakete:").CombinedOutput()
+	if err != nil && strings.Contains(string(output), "unknown locale") {
+		suite.T().Skip("the German dictionary has not been generated from CLDR")
+	}
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Start 🚀\n", string(output))
+
+	// A locale from the environment with a dictionary is used
+	cmd := exec.Command(suite.binaryPath, ":rakete: :rocket:")
+	cmd.Env = append(os.Environ(), "LC_ALL=de_DE.UTF-8")
+	output, err = cmd.Output()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "🚀 🚀\n", string(output))
+}
+
+// TestCompletion tests the completion scripts and the completions they request
+func (suite *IntegrationTestSuite) TestCompletion() {
+	for shell, marker := range map[string]string{
+		"bash":       "complete -o bashdefault -o default -F _emojify_completion emojify",
+		"zsh":        "#compdef emojify",
+		"fish":       "function __fish_emojify_complete_aliases",
+		"powershell": "Register-ArgumentCompleter -Native -CommandName 'emojify'",
+	} {
+		output, err := exec.Command(suite.binaryPath, "completion", shell).Output()
+		require.NoError(suite.T(), err, "Shell %s", shell)
+		assert.Contains(suite.T(), string(output), marker)
+	}
+
+	assert.Error(suite.T(), exec.Command(suite.binaryPath, "completion", "tcsh").Run())
+
+	// The bash script runs the typed words as arguments instead of evaluating the line
+	output, err := exec.Command(suite.binaryPath, "completion", "bash").Output()
+	require.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), string(output), "eval")
+	assert.NotContains(suite.T(), string(output), "compgen")
+
+	complete := func(args ...string) string {
+		cmd := exec.Command(suite.binaryPath, append(args, "--generate-shell-completion")...)
+		cmd.Env = append(os.Environ(), "SHELL=/bin/bash")
+		output, err := cmd.Output()
+		require.NoError(suite.T(), err)
+		return string(output)
+	}
+
+	assert.Equal(suite.T(), ":rock:\t🪨\n:rocket:\t🚀\n", complete("Deploy", ":rock"))
+	assert.Equal(suite.T(), ":rocket:\t🚀\n", complete("info", ":rocke"))
+	assert.Contains(suite.T(), complete("--loc"), "--locale\n")
+	assert.Contains(suite.T(), complete(""), "search\n")
+
+	dir := suite.T().TempDir()
+	registry := filepath.Join(dir, "emoji.json")
+	require.NoError(suite.T(), os.WriteFile(registry, []byte(`{"partyparrot": "https://example.com/parrot.gif"}`), 0o644))
+	configFile := filepath.Join(dir, "config.json")
+	require.NoError(suite.T(), os.WriteFile(configFile, []byte(`{"custom_emoji": "`+filepath.ToSlash(registry)+`"}`), 0o644))
+
+	assert.Equal(suite.T(), ":partyparrot:\tcustom emoji\n", complete("--config", configFile, ":partyp"))
+}
+
 // TestIntegration runs all integration tests
 func TestIntegration(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
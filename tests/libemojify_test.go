@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// LibEmojifyTestSuite tests the C shared library from a C program
+type LibEmojifyTestSuite struct {
+	suite.Suite
+	dir string
+	cc  string
+}
+
+// SetupSuite builds libemojify with -buildmode=c-shared
+func (suite *LibEmojifyTestSuite) SetupSuite() {
+	if testing.Short() {
+		suite.T().Skip("Skipping C shared library build in short mode")
+	}
+
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("Skipping C shared library test on Windows")
+	}
+
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		suite.T().Skip("Skipping C shared library test: no C compiler")
+	}
+
+	suite.cc = cc
+	suite.dir = suite.T().TempDir()
+
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", filepath.Join(suite.dir, "libemojify.so"), "./cmd/libemojify")
+	cmd.Dir = ".."
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=1")
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(suite.T(), err, "Failed to build libemojify: %s", output)
+}
+
+// TestHeader tests that the generated header declares the exported functions
+func (suite *LibEmojifyTestSuite) TestHeader() {
+	header, err := os.ReadFile(filepath.Join(suite.dir, "libemojify.h"))
+	require.NoError(suite.T(), err)
+
+	for _, declaration := range []string{
+		"char* emojify_encode(char* text);",
+		"char* emojify_encode_with_options(char* text, char* options, char** errmsg);",
+		"char* emojify_decode(char* text);",
+		"char* emojify_lookup(char* query);",
+		"char* emojify_search(char* query, int limit);",
+		"char* emojify_version(void);",
+		"void emojify_free(char* s);",
+	} {
+		assert.Contains(suite.T(), string(header), declaration)
+	}
+}
+
+// TestCProgram tests the library from a C program linked against it
+func (suite *LibEmojifyTestSuite) TestCProgram() {
+	source, err := filepath.Abs(filepath.Join("testdata", "libemojify.c"))
+	require.NoError(suite.T(), err)
+
+	program := filepath.Join(suite.dir, "libemojify_test")
+	cmd := exec.Command(suite.cc, "-o", program, source, "-I", suite.dir, "-L", suite.dir, "-lemojify", "-Wl,-rpath,"+suite.dir)
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(suite.T(), err, "Failed to compile C program: %s", output)
+
+	output, err = exec.Command(program).CombinedOutput()
+	require.NoError(suite.T(), err, "C program failed: %s", output)
+	assert.Equal(suite.T(), "ok", strings.TrimSpace(string(output)))
+}
+
+// TestLibEmojify runs all C shared library tests
+func TestLibEmojify(t *testing.T) {
+	suite.Run(t, new(LibEmojifyTestSuite))
+}
@@ -0,0 +1,66 @@
+/* Exercises the libemojify C ABI; built and run by libemojify_test.go */
+
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include "libemojify.h"
+
+static int failures = 0;
+
+/* expect compares a library result with the expected string, then frees it */
+static void expect(const char *name, char *got, const char *want)
+{
+    if (want == NULL ? got != NULL : got == NULL || strcmp(got, want) != 0) {
+        fprintf(stderr, "%s: got \"%s\", want \"%s\"\n", name, got ? got : "(null)", want ? want : "(null)");
+        failures++;
+    }
+
+    emojify_free(got);
+}
+
+/* expect_contains checks that a library result contains needle, then frees it */
+static void expect_contains(const char *name, char *got, const char *needle)
+{
+    if (got == NULL || strstr(got, needle) == NULL) {
+        fprintf(stderr, "%s: got \"%s\", want it to contain \"%s\"\n", name, got ? got : "(null)", needle);
+        failures++;
+    }
+
+    emojify_free(got);
+}
+
+int main(void)
+{
+    char *error = NULL;
+
+    expect("encode", emojify_encode("Ship it :rocket:"), "Ship it 🚀");
+    expect("encode NULL", emojify_encode(NULL), NULL);
+    expect("decode", emojify_decode("Ship it 🚀"), "Ship it :rocket:");
+
+    expect("encode with options", emojify_encode_with_options(":wave:", "{\"skinTone\": 3}", &error), "👋🏽");
+    expect("encode with NULL options", emojify_encode_with_options(":tada:", NULL, NULL), "🎉");
+
+    expect("encode with invalid options", emojify_encode_with_options(":wave:", "{\"skinTone\": 9}", &error), NULL);
+    if (error == NULL) {
+        fprintf(stderr, "encode with invalid options: no error message\n");
+        failures++;
+    }
+    emojify_free(error);
+
+    expect_contains("lookup alias", emojify_lookup(":rocket:"), "\"emoji\":\"🚀\"");
+    expect_contains("lookup emoji", emojify_lookup("🚀"), "\"alias\":\":rocket:\"");
+    expect("lookup unknown", emojify_lookup(":no_such_emoji:"), NULL);
+    expect_contains("search", emojify_search("rocket", 1), "🚀");
+    expect("search no results", emojify_search("no-such-emoji-anywhere", 0), "[]");
+    expect_contains("version", emojify_version(), "");
+
+    emojify_free(NULL);
+
+    if (failures > 0) {
+        return 1;
+    }
+
+    printf("ok\n");
+    return 0;
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+)
+
+// WasmTestSuite runs the WASI build of emojify under wazero
+type WasmTestSuite struct {
+	suite.Suite
+	dir     string
+	modules map[string][]byte
+}
+
+// SetupSuite builds the full and lite WASI modules
+func (suite *WasmTestSuite) SetupSuite() {
+	if testing.Short() {
+		suite.T().Skip("Skipping WebAssembly builds in short mode")
+	}
+
+	suite.dir = suite.T().TempDir()
+	suite.modules = make(map[string][]byte)
+
+	for name, tags := range map[string]string{"full": "", "lite": "emojify_lite"} {
+		path := filepath.Join(suite.dir, name+".wasm")
+		suite.build(path, "wasip1", tags, "./cmd/emojify-wasi")
+
+		module, err := os.ReadFile(path)
+		require.NoError(suite.T(), err)
+		suite.modules[name] = module
+	}
+}
+
+// build compiles pkg for GOOS and GOARCH=wasm
+func (suite *WasmTestSuite) build(output, goos, tags, pkg string) {
+	cmd := exec.Command("go", "build", "-trimpath", "-ldflags", "-s -w", "-tags", tags, "-o", output, pkg)
+	cmd.Dir = ".."
+	cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH=wasm")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(suite.T(), err, "Failed to build %s for %s: %s", pkg, goos, out)
+}
+
+// run executes a WASI module with the given arguments and stdin, returning stdout and the exit code
+func (suite *WasmTestSuite) run(module []byte, stdin string, args ...string) (string, uint32) {
+	ctx := context.Background()
+
+	runtime := wazero.NewRuntime(ctx)
+	defer runtime.Close(ctx)
+
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+
+	var stdout, stderr bytes.Buffer
+	config := wazero.NewModuleConfig().
+		WithArgs(append([]string{"emojify"}, args...)...).
+		WithStdin(strings.NewReader(stdin)).
+		WithStdout(&stdout).
+		WithStderr(&stderr)
+
+	_, err := runtime.InstantiateWithConfig(ctx, module, config)
+	if exitErr, ok := err.(*sys.ExitError); ok {
+		return stdout.String(), exitErr.ExitCode()
+	}
+
+	require.NoError(suite.T(), err, "stderr: %s", stderr.String())
+	return stdout.String(), 0
+}
+
+// TestEncodeDecode tests converting text in both builds
+func (suite *WasmTestSuite) TestEncodeDecode() {
+	for name, module := range suite.modules {
+		suite.Run(name, func() {
+			output, code := suite.run(module, "Ship it :rocket:", "encode")
+			assert.Equal(suite.T(), uint32(0), code)
+			assert.Equal(suite.T(), "Ship it 🚀", output)
+
+			output, code = suite.run(module, "Ship it 🚀", "decode")
+			assert.Equal(suite.T(), uint32(0), code)
+			assert.Equal(suite.T(), "Ship it :rocket:", output)
+		})
+	}
+}
+
+// TestEncodeOptions tests that encoding flags reach the processor
+func (suite *WasmTestSuite) TestEncodeOptions() {
+	module := suite.modules["full"]
+
+	output, code := suite.run(module, ":wave:", "encode", "-skin-tone", "3")
+	assert.Equal(suite.T(), uint32(0), code)
+	assert.Equal(suite.T(), "👋🏽", output)
+
+	output, code = suite.run(module, "fix: crash", "encode", "-conventional")
+	assert.Equal(suite.T(), uint32(0), code)
+	assert.Equal(suite.T(), "🐛 fix: crash", output)
+
+	_, code = suite.run(module, ":wave:", "encode", "-skin-tone", "9")
+	assert.NotEqual(suite.T(), uint32(0), code)
+}
+
+// TestSearch tests that search prints a JSON array
+func (suite *WasmTestSuite) TestSearch() {
+	output, code := suite.run(suite.modules["full"], "", "search", "rocket", "1")
+	require.Equal(suite.T(), uint32(0), code)
+
+	var results []map[string]any
+	require.NoError(suite.T(), json.Unmarshal([]byte(output), &results))
+	require.Len(suite.T(), results, 1)
+	assert.Equal(suite.T(), "🚀", results[0]["emoji"])
+}
+
+// TestUnknownCommand tests that usage errors exit with a non-zero status
+func (suite *WasmTestSuite) TestUnknownCommand() {
+	_, code := suite.run(suite.modules["full"], "", "explode")
+	assert.NotEqual(suite.T(), uint32(0), code)
+}
+
+// TestLiteSize tests that the lite build leaves out the Unicode dictionary
+func (suite *WasmTestSuite) TestLiteSize() {
+	assert.Less(suite.T(), len(suite.modules["lite"]), len(suite.modules["full"]))
+}
+
+// TestJavaScriptBuild tests that the browser entry point compiles
+func (suite *WasmTestSuite) TestJavaScriptBuild() {
+	suite.build(filepath.Join(suite.dir, "emojify.wasm"), "js", "", "./cmd/emojify-wasm")
+}
+
+// TestWasmIntegration runs all WebAssembly integration tests
+func TestWasmIntegration(t *testing.T) {
+	suite.Run(t, new(WasmTestSuite))
+}